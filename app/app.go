@@ -143,7 +143,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/burnt-labs/xion/app/upgrades"
-	v7 "github.com/burnt-labs/xion/app/upgrades/v7"
+	v8 "github.com/burnt-labs/xion/app/upgrades/v8"
 	owasm "github.com/burnt-labs/xion/wasmbindings"
 	"github.com/burnt-labs/xion/x/globalfee"
 	"github.com/burnt-labs/xion/x/jwk"
@@ -171,7 +171,7 @@ var (
 	// of "EnableAllProposals" (takes precedence over ProposalsEnabled)
 	// https://github.com/CosmWasm/wasmd/blob/02a54d33ff2c064f3539ae12d75d027d9c665f05/x/wasm/internal/types/proposal.go#L28-L34
 	EnableSpecificProposals = ""
-	Upgrades                = []upgrades.Upgrade{v7.Upgrade}
+	Upgrades                = []upgrades.Upgrade{v8.Upgrade}
 )
 
 // These constants are derived from the above variables.
@@ -605,7 +605,9 @@ func NewWasmApp(
 	app.JwkKeeper = jwkkeeper.NewKeeper(
 		appCodec,
 		keys[jwktypes.StoreKey],
-		app.GetSubspace(jwktypes.ModuleName))
+		app.BankKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		appCodec,
@@ -740,6 +742,7 @@ func NewWasmApp(
 	app.XionKeeper = xionkeeper.NewKeeper(
 		appCodec,
 		keys[xiontypes.StoreKey],
+		keys[wasmtypes.StoreKey],
 		app.GetSubspace(xiontypes.ModuleName),
 		app.BankKeeper,
 		app.AccountKeeper,
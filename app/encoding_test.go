@@ -0,0 +1,142 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	jwktypes "github.com/burnt-labs/xion/x/jwk/types"
+	minttypes "github.com/burnt-labs/xion/x/mint/types"
+	xiontypes "github.com/burnt-labs/xion/x/xion/types"
+)
+
+// TestSignModesRoundTrip ensures every xion message and fee allowance can be
+// signed in all the enabled sign modes, and that the sign bytes survive an
+// encode/decode round trip of the transaction.
+func TestSignModesRoundTrip(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	txConfig := encodingConfig.TxConfig
+
+	privKey := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	// SIGN_MODE_DIRECT_AUX signers cannot pay the fees
+	feePayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uxion", 100))
+
+	basicAllowance := &feegrant.BasicAllowance{SpendLimit: coins}
+	authzAllowance, err := xiontypes.NewAuthzAllowance(basicAllowance, addr)
+	require.NoError(t, err)
+	contractsAllowance, err := xiontypes.NewContractsAllowance(basicAllowance, []sdk.AccAddress{addr})
+	require.NoError(t, err)
+	grantAuthzAllowance, err := feegrant.NewMsgGrantAllowance(authzAllowance, addr, addr)
+	require.NoError(t, err)
+	grantContractsAllowance, err := feegrant.NewMsgGrantAllowance(contractsAllowance, addr, addr)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		msg       sdk.Msg
+		aminoName string
+	}{
+		{xiontypes.NewMsgSend(addr, addr, coins), "xion/MsgSend"},
+		{xiontypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(addr, coins)}, []banktypes.Output{banktypes.NewOutput(addr, coins)}), "xion/MsgMultiSend"},
+		{&xiontypes.MsgSetPlatformPercentage{Authority: addr.String(), PlatformPercentage: 100}, "xion/MsgSetPlatformPercentage"},
+		{xiontypes.NewMsgRegisterWebAuthNCredential(addr, "https://xion.burnt.com", "challenge", []byte("{}")), "xion/MsgRegisterWebAuthNCredential"},
+		{xiontypes.NewMsgRemoveWebAuthNCredential(addr, []byte("credential")), "xion/MsgRemoveWebAuthNCredential"},
+		{xiontypes.NewMsgAuthenticateWebAuthNCredential(addr, "https://xion.burnt.com", "challenge", []byte("{}")), "xion/MsgAuthenticateWebAuthNCredential"},
+		{&xiontypes.MsgSetAttestationPolicy{Authority: addr.String(), Policy: xiontypes.AttestationPolicy{Formats: []string{"packed"}}}, "xion/MsgSetAttestationPolicy"},
+		{&xiontypes.MsgMigrateAccounts{Authority: addr.String(), OldCodeIds: []uint64{1}, NewCodeId: 2, MigrateMsg: []byte(`{}`)}, "xion/MsgMigrateAccounts"},
+		{&xiontypes.MsgCancelAccountMigration{Authority: addr.String()}, "xion/MsgCancelAccountMigration"},
+		{grantAuthzAllowance, "xion/AuthzAllowance"},
+		{grantContractsAllowance, "xion/ContractsAllowance"},
+		{jwktypes.NewMsgCreateAudienceClaim(addr, make([]byte, 32)), "jwk/MsgCreateAudienceClaim"},
+		{jwktypes.NewMsgDeleteAudienceClaim(addr, make([]byte, 32)), "jwk/MsgDeleteAudienceClaim"},
+		{jwktypes.NewMsgCreateAudience(addr.String(), "aud", "{}", "", ""), "jwk/MsgCreateAudience"},
+		{jwktypes.NewMsgUpdateAudience(addr.String(), addr.String(), "aud", "{}", "", ""), "jwk/MsgUpdateAudience"},
+		{jwktypes.NewMsgDeleteAudience(addr.String(), "aud"), "jwk/MsgDeleteAudience"},
+		{jwktypes.NewMsgConsumeJWT(addr.String(), "aud", "sub", "token"), "jwk/MsgConsumeJWT"},
+		{jwktypes.NewMsgUpdateParams(addr.String(), jwktypes.DefaultParams()), "jwk/MsgUpdateParams"},
+		{&minttypes.MsgUpdateParams{Authority: addr.String(), Params: minttypes.DefaultParams()}, "xion/x/mint/MsgUpdateParams"},
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       "xion-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        privKey.PubKey(),
+	}
+
+	for _, tc := range testCases {
+		for _, mode := range txConfig.SignModeHandler().Modes() {
+			t.Run(fmt.Sprintf("%s/%s", tc.aminoName, mode), func(t *testing.T) {
+				txBuilder := txConfig.NewTxBuilder()
+				require.NoError(t, txBuilder.SetMsgs(tc.msg))
+				txBuilder.SetFeeAmount(coins)
+				txBuilder.SetGasLimit(200_000)
+				txBuilder.SetFeePayer(feePayer)
+				require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+					PubKey:   privKey.PubKey(),
+					Data:     &signing.SingleSignatureData{SignMode: mode},
+					Sequence: signerData.Sequence,
+				}))
+
+				signBytes, err := txConfig.SignModeHandler().GetSignBytes(mode, signerData, txBuilder.GetTx())
+				require.NoError(t, err)
+				if mode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+					require.Contains(t, string(signBytes), fmt.Sprintf(`"type":"%s"`, tc.aminoName))
+				}
+
+				txBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+				require.NoError(t, err)
+				decodedTx, err := txConfig.TxDecoder()(txBz)
+				require.NoError(t, err)
+				decodedSignBytes, err := txConfig.SignModeHandler().GetSignBytes(mode, signerData, decodedTx)
+				require.NoError(t, err)
+				require.Equal(t, signBytes, decodedSignBytes)
+
+				txJSON, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+				require.NoError(t, err)
+				decodedTx, err = txConfig.TxJSONDecoder()(txJSON)
+				require.NoError(t, err)
+				decodedSignBytes, err = txConfig.SignModeHandler().GetSignBytes(mode, signerData, decodedTx)
+				require.NoError(t, err)
+				require.Equal(t, signBytes, decodedSignBytes)
+			})
+		}
+	}
+}
+
+// TestLegacyAminoJSONRoundTrip ensures the amino JSON encoding of the xion
+// messages can be decoded back into the original message.
+func TestLegacyAminoJSONRoundTrip(t *testing.T) {
+	cdc := MakeEncodingConfig().Amino
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uxion", 100))
+
+	msgs := []sdk.Msg{
+		xiontypes.NewMsgSend(addr, addr, coins),
+		&xiontypes.MsgSetPlatformPercentage{Authority: addr.String(), PlatformPercentage: 100},
+		jwktypes.NewMsgCreateAudienceClaim(addr, make([]byte, 32)),
+		jwktypes.NewMsgCreateAudience(addr.String(), "aud", "{}", "", ""),
+		jwktypes.NewMsgUpdateAudience(addr.String(), addr.String(), "aud", "{}", "", ""),
+		&minttypes.MsgUpdateParams{Authority: addr.String(), Params: minttypes.DefaultParams()},
+	}
+
+	for _, msg := range msgs {
+		bz, err := cdc.MarshalJSON(msg)
+		require.NoError(t, err)
+
+		var decoded sdk.Msg
+		require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+		require.Equal(t, msg, decoded)
+	}
+}
@@ -0,0 +1,18 @@
+package v8
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/burnt-labs/xion/app/upgrades"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v8.0.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        store.StoreUpgrades{},
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	v8 "github.com/burnt-labs/xion/app/upgrades/v8"
+	jwktypes "github.com/burnt-labs/xion/x/jwk/types"
+	xiontypes "github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestUpgradeV8(t *testing.T) {
+	app := Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-local-1", Time: time.Now().UTC()})
+
+	// the jwk params are still in their legacy subspace
+	subspace := app.GetSubspace(jwktypes.ModuleName)
+	if !subspace.HasKeyTable() {
+		subspace = subspace.WithKeyTable(jwktypes.ParamKeyTable())
+	}
+	subspace.Set(ctx, jwktypes.ParamStoreKeyTimeOffset, uint64(time.Second))
+	subspace.Set(ctx, jwktypes.ParamStoreKeyDeploymentGas, uint64(20_000))
+	ctx.KVStore(app.GetKey(jwktypes.StoreKey)).Delete(jwktypes.ParamsKey)
+	require.Equal(t, jwktypes.Params{}, app.JwkKeeper.GetParams(ctx))
+
+	// and an audience has no claim
+	admin := sdk.AccAddress([]byte("admin_______________")).String()
+	app.JwkKeeper.SetAudience(ctx, jwktypes.Audience{Admin: admin, Aud: "legacy"})
+
+	versions := app.ModuleManager.GetVersionMap()
+	versions[jwktypes.ModuleName] = 2
+	versions[xiontypes.ModuleName] = 1
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, versions)
+
+	require.True(t, app.UpgradeKeeper.HasHandler(v8.UpgradeName))
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: v8.UpgradeName, Height: ctx.BlockHeight()})
+
+	require.Equal(t, app.ModuleManager.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+
+	params := app.JwkKeeper.GetParams(ctx)
+	require.Equal(t, uint64(time.Second), params.TimeOffset)
+	require.Equal(t, uint64(20_000), params.DeploymentGas)
+	require.True(t, params.ClaimDeposit.IsEqual(jwktypes.DefaultParams().ClaimDeposit))
+	require.Equal(t, jwktypes.DefaultParams().ClaimExpiration, params.ClaimExpiration)
+
+	audHash := sha256.Sum256([]byte("legacy"))
+	claim, found := app.JwkKeeper.GetAudienceClaim(ctx, audHash[:])
+	require.True(t, found)
+	require.Equal(t, admin, claim.Signer)
+
+	// accounts are only migrated on the known chains
+	_, found = app.XionKeeper.GetAccountMigration(ctx)
+	require.False(t, found)
+}
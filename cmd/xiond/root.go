@@ -38,6 +38,7 @@ import (
 
 	"github.com/burnt-labs/xion/app"
 	"github.com/burnt-labs/xion/app/params"
+	xioncli "github.com/burnt-labs/xion/x/xion/client/cli"
 )
 
 // NewRootCmd creates a new root command for xiond. It is called once in the
@@ -166,6 +167,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		queryCommand(),
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
+		xionCommand(),
 	)
 	// add rosetta
 	rootCmd.AddCommand(rosettaCmd.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
@@ -209,6 +211,21 @@ func queryCommand() *cobra.Command {
 	return cmd
 }
 
+func xionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "xion",
+		Short:                      "Xion local tooling subcommands",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		xioncli.NewWebAuthnCmd(),
+	)
+
+	return cmd
+}
+
 func txCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "tx",
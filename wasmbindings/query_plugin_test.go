@@ -115,7 +115,7 @@ func (suite *StargateTestSuite) TestWebauthNStargateQuerier() {
 				suite.Require().NoError(err)
 				return bz
 			},
-			responseProtoStruct: &xiontypes.QueryWebAuthNVerifyRegisterResponse{},
+			responseProtoStruct: &xiontypes.QueryWebAuthNVerifyAuthenticateResponse{},
 		},
 
 		// TODO: errors in wrong query in state machine
@@ -76,6 +76,11 @@ func init() {
 	// xion queries
 	setWhitelistedQuery("/xion.v1.Query/WebAuthNVerifyRegister", &xiontypes.QueryWebAuthNVerifyRegisterResponse{})
 	setWhitelistedQuery("/xion.v1.Query/WebAuthNVerifyAuthenticate", &xiontypes.QueryWebAuthNVerifyAuthenticateResponse{})
+	setWhitelistedQuery("/xion.v1.Query/WebAuthNVerifyAuthenticateStored", &xiontypes.QueryWebAuthNVerifyAuthenticateResponse{})
+	setWhitelistedQuery("/xion.v1.Query/WebAuthNCredential", &xiontypes.QueryWebAuthNCredentialResponse{})
+	setWhitelistedQuery("/xion.v1.Query/WebAuthNCredentials", &xiontypes.QueryWebAuthNCredentialsResponse{})
+	setWhitelistedQuery("/xion.v1.Query/AttestationPolicy", &xiontypes.QueryAttestationPolicyResponse{})
+	setWhitelistedQuery("/xion.v1.Query/WebAuthNChallenge", &xiontypes.QueryWebAuthNChallengeResponse{})
 	setWhitelistedQuery("/xion.jwk.v1.Query/AudienceAll", &jwktypes.QueryAllAudienceResponse{})
 	setWhitelistedQuery("/xion.jwk.v1.Query/Audience", &jwktypes.QueryGetAudienceResponse{})
 	setWhitelistedQuery("/xion.jwk.v1.Query/Params", &jwktypes.QueryParamsResponse{})
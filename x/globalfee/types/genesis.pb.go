@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -150,35 +151,36 @@ func init() {
 func init() { proto.RegisterFile("xion/globalfee/v1/genesis.proto", fileDescriptor_a27689c4e7986e7d) }
 
 var fileDescriptor_a27689c4e7986e7d = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x82, 0x22, 0xe1, 0xb2, 0x28, 0x56, 0x17, 0x6e, 0x55, 0xd9, 0x91, 0x17, 0x28,
-	0x12, 0xed, 0x8c, 0x52, 0x76, 0x2c, 0x0d, 0x6a, 0x84, 0x50, 0x45, 0x15, 0xca, 0x06, 0x16, 0xd6,
-	0x1b, 0x33, 0x1d, 0x46, 0x78, 0x66, 0xac, 0xbc, 0x49, 0x95, 0x2c, 0xb9, 0x01, 0x07, 0xe0, 0x04,
-	0x9c, 0x81, 0x03, 0x74, 0xd9, 0x25, 0xab, 0x80, 0x92, 0x5d, 0x97, 0x9c, 0x00, 0x8d, 0xc7, 0x40,
-	0xab, 0x34, 0x2b, 0x5b, 0x7e, 0xbf, 0xff, 0x87, 0x9f, 0x5e, 0x98, 0xce, 0xa4, 0xd1, 0x54, 0x54,
-	0x86, 0x41, 0x75, 0xce, 0x39, 0xbd, 0x18, 0x52, 0xc1, 0x35, 0x47, 0x89, 0xa4, 0x9e, 0x18, 0x6b,
-	0xa2, 0x47, 0x0e, 0x20, 0xff, 0x00, 0x72, 0x31, 0xdc, 0xdb, 0x11, 0x46, 0x98, 0x66, 0x4a, 0xdd,
-	0x9b, 0x07, 0xf7, 0x92, 0xd2, 0xa0, 0x32, 0x48, 0x19, 0xa0, 0xb3, 0x61, 0xdc, 0xc2, 0x90, 0x96,
-	0x46, 0x6a, 0x3f, 0xcf, 0xde, 0x87, 0x0f, 0x47, 0xde, 0xf9, 0x8d, 0x05, 0xcb, 0xa3, 0x57, 0x61,
-	0xaf, 0x86, 0x09, 0x28, 0x8c, 0x83, 0x7e, 0x30, 0xd8, 0x3a, 0xda, 0x25, 0x6b, 0x49, 0xe4, 0xb4,
-	0x01, 0xf2, 0xf8, 0x72, 0x91, 0x76, 0xae, 0x17, 0xe9, 0xb6, 0x17, 0x1c, 0x18, 0x25, 0x2d, 0x57,
-	0xb5, 0x9d, 0x8f, 0x5b, 0x8b, 0xec, 0x6b, 0x37, 0xec, 0x79, 0x38, 0xfa, 0x1e, 0x84, 0x91, 0x92,
-	0x5a, 0xaa, 0xa9, 0x2a, 0x04, 0x60, 0x51, 0x4f, 0x64, 0xc9, 0x5d, 0x48, 0x77, 0xb0, 0x75, 0xb4,
-	0x4f, 0x7c, 0x4b, 0xe2, 0x5a, 0x92, 0xb6, 0x25, 0x79, 0xc1, 0xcb, 0xe7, 0x46, 0xea, 0xbc, 0x6e,
-	0x73, 0xf6, 0xd7, 0xf5, 0xff, 0x33, 0x7f, 0x2f, 0xd2, 0xdd, 0x39, 0xa8, 0xea, 0x59, 0xb6, 0x4e,
-	0x65, 0xdf, 0x7e, 0xa6, 0x4f, 0x84, 0xb4, 0x1f, 0xa7, 0x8c, 0x94, 0x46, 0xd1, 0x76, 0x25, 0xfe,
-	0x71, 0x88, 0x1f, 0x3e, 0x51, 0x3b, 0xaf, 0x39, 0xfe, 0x0d, 0xc4, 0xf1, 0x76, 0xeb, 0x31, 0x02,
-	0x3c, 0x6d, 0x1c, 0xa2, 0xcf, 0x41, 0x18, 0xb3, 0x79, 0x0d, 0x88, 0x85, 0x92, 0xba, 0x38, 0xe7,
-	0xbc, 0x50, 0x28, 0x8a, 0x46, 0x17, 0xdf, 0xeb, 0x77, 0x07, 0x0f, 0xf2, 0x97, 0xd7, 0x8b, 0x34,
-	0xdb, 0xc4, 0xdc, 0x2a, 0x9a, 0xfa, 0xa2, 0x9b, 0xd8, 0x6c, 0xbc, 0xe3, 0x47, 0x27, 0x52, 0x1f,
-	0x73, 0x7e, 0x82, 0xe2, 0xcc, 0x7d, 0x8e, 0x5e, 0x87, 0x8f, 0x15, 0xcc, 0x0a, 0x6b, 0x2c, 0x54,
-	0xc5, 0x1d, 0x62, 0xf7, 0xc3, 0x53, 0x04, 0xc1, 0xe3, 0x6e, 0x3f, 0x18, 0xdc, 0x1f, 0xa7, 0x0a,
-	0x66, 0x67, 0x0e, 0xce, 0x6f, 0xbb, 0x8d, 0x00, 0xdf, 0x3a, 0x2c, 0x3f, 0xbe, 0x5c, 0x26, 0xc1,
-	0xd5, 0x32, 0x09, 0x7e, 0x2d, 0x93, 0xe0, 0xcb, 0x2a, 0xe9, 0x5c, 0xad, 0x92, 0xce, 0x8f, 0x55,
-	0xd2, 0x79, 0x77, 0x70, 0x63, 0x5b, 0x6c, 0x3a, 0xd1, 0xf6, 0xb0, 0x02, 0x86, 0xb4, 0xb9, 0xca,
-	0xd9, 0x8d, 0xbb, 0x6c, 0xfa, 0xb2, 0x5e, 0x73, 0x4a, 0x4f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0x65, 0x13, 0x6c, 0xb6, 0x02, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x82, 0x22, 0xe1, 0x32, 0xb4, 0x56, 0x25, 0xdc, 0x2a, 0xb2, 0x23, 0x0f, 0x28,
+	0x82, 0xf6, 0x4e, 0x29, 0x5b, 0xc7, 0x80, 0x1a, 0x21, 0x54, 0x51, 0x85, 0xb2, 0xc0, 0x60, 0x3d,
+	0x9b, 0xeb, 0x71, 0xc2, 0x77, 0x67, 0xe5, 0x5d, 0xaa, 0x64, 0x64, 0x65, 0xe2, 0x73, 0x30, 0x31,
+	0x23, 0x3e, 0x40, 0xc7, 0x8e, 0x4c, 0x01, 0x25, 0x03, 0x52, 0x47, 0x3e, 0x01, 0x3a, 0x9f, 0x81,
+	0x56, 0x26, 0x8b, 0x6d, 0xdd, 0xfb, 0xbd, 0xff, 0xff, 0x7f, 0xcf, 0xcf, 0x8f, 0x67, 0x42, 0x2b,
+	0xca, 0x0b, 0x9d, 0x41, 0x71, 0xc6, 0x18, 0x3d, 0x1f, 0x50, 0xce, 0x14, 0x43, 0x81, 0xa4, 0x9c,
+	0x68, 0xa3, 0x83, 0x2d, 0x0b, 0x90, 0xbf, 0x00, 0x39, 0x1f, 0xec, 0x6e, 0x73, 0xcd, 0x75, 0x55,
+	0xa5, 0xf6, 0xcb, 0x81, 0xbb, 0x5b, 0x20, 0x85, 0xd2, 0xb4, 0x7a, 0xd6, 0x47, 0x51, 0xae, 0x51,
+	0x6a, 0xa4, 0x19, 0xa0, 0x55, 0xce, 0x98, 0x81, 0x01, 0xcd, 0xb5, 0x50, 0xae, 0x9e, 0xbc, 0xf6,
+	0xef, 0x8e, 0x9c, 0xd9, 0x0b, 0x03, 0x86, 0x05, 0xcf, 0xfc, 0x4e, 0x09, 0x13, 0x90, 0x18, 0x7a,
+	0x3d, 0xaf, 0xbf, 0x71, 0xb0, 0x43, 0x1a, 0xe6, 0xe4, 0xa4, 0x02, 0x86, 0xe1, 0xc5, 0x22, 0x6e,
+	0x5d, 0x2d, 0xe2, 0x4d, 0xd7, 0xb0, 0xa7, 0xa5, 0x30, 0x4c, 0x96, 0x66, 0x3e, 0xae, 0x25, 0x92,
+	0x2f, 0x6d, 0xbf, 0xe3, 0xe0, 0xe0, 0xab, 0xe7, 0x07, 0x52, 0x28, 0x21, 0xa7, 0x32, 0xe5, 0x80,
+	0x69, 0x39, 0x11, 0x39, 0xb3, 0x26, 0xed, 0xfe, 0xc6, 0x41, 0x97, 0xb8, 0x94, 0xc4, 0xa6, 0x24,
+	0x75, 0x4a, 0xf2, 0x84, 0xe5, 0x8f, 0xb5, 0x50, 0xc3, 0xb2, 0xf6, 0xe9, 0x36, 0xfb, 0xff, 0x79,
+	0xfe, 0x5a, 0xc4, 0x3b, 0x73, 0x90, 0xc5, 0x61, 0xd2, 0xa4, 0x92, 0x4f, 0xdf, 0xe3, 0x87, 0x5c,
+	0x98, 0xb7, 0xd3, 0x8c, 0xe4, 0x5a, 0xd2, 0x7a, 0x24, 0xee, 0xb5, 0x8f, 0x6f, 0xde, 0x51, 0x33,
+	0x2f, 0x19, 0xfe, 0x31, 0xc4, 0xf1, 0x66, 0xad, 0x31, 0x02, 0x3c, 0xa9, 0x14, 0x82, 0xf7, 0x9e,
+	0x1f, 0x66, 0xf3, 0x12, 0x10, 0x53, 0x29, 0x54, 0x7a, 0xc6, 0x58, 0x2a, 0x91, 0xa7, 0x55, 0x5f,
+	0x78, 0xab, 0xd7, 0xee, 0xdf, 0x19, 0x3e, 0xbd, 0x5a, 0xc4, 0xc9, 0x3a, 0xe6, 0x46, 0xd0, 0xd8,
+	0x05, 0x5d, 0xc7, 0x26, 0xe3, 0x6d, 0x57, 0x3a, 0x16, 0xea, 0x88, 0xb1, 0x63, 0xe4, 0xa7, 0xf6,
+	0x38, 0x78, 0xee, 0xdf, 0x97, 0x30, 0x4b, 0x8d, 0x36, 0x50, 0xa4, 0xff, 0x69, 0xb6, 0x17, 0x9e,
+	0x22, 0x70, 0x16, 0xb6, 0x7b, 0x5e, 0xff, 0xf6, 0x38, 0x96, 0x30, 0x3b, 0xb5, 0xf0, 0xf0, 0xa6,
+	0xda, 0x08, 0xf0, 0xa5, 0xc5, 0x0e, 0xbb, 0x1f, 0x7e, 0x7e, 0x7e, 0x70, 0xaf, 0xda, 0xbe, 0xd9,
+	0xb5, 0xfd, 0xab, 0x7f, 0xef, 0xd1, 0xc5, 0x32, 0xf2, 0x2e, 0x97, 0x91, 0xf7, 0x63, 0x19, 0x79,
+	0x1f, 0x57, 0x51, 0xeb, 0x72, 0x15, 0xb5, 0xbe, 0xad, 0xa2, 0xd6, 0xab, 0xbd, 0x6b, 0xb3, 0xcc,
+	0xa6, 0x13, 0x65, 0xf6, 0x0b, 0xc8, 0x90, 0x36, 0x84, 0xaa, 0xdb, 0x64, 0x9d, 0x6a, 0xd1, 0x1e,
+	0xfd, 0x1e, 0x00, 0x18, 0x0b, 0x6c, 0x9b, 0xe7, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -28,6 +28,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateAudience())
 	cmd.AddCommand(CmdUpdateAudience())
 	cmd.AddCommand(CmdDeleteAudience())
+	cmd.AddCommand(CmdAddAudienceKey())
+	cmd.AddCommand(CmdRemoveAudienceKey())
+	cmd.AddCommand(CmdSetAudienceKeyWindow())
+	cmd.AddCommand(CmdConsumeJWT())
+	cmd.AddCommand(CmdUpdateParams())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
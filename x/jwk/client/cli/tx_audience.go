@@ -2,6 +2,8 @@ package cli
 
 import (
 	"crypto/sha256"
+	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,7 +15,11 @@ import (
 )
 
 const (
-	FlagNewAdmin = "new-admin"
+	FlagNewAdmin  = "new-admin"
+	FlagNotBefore = "not-before"
+	FlagNotAfter  = "not-after"
+	FlagIssuer    = "issuer"
+	FlagAzp       = "azp"
 )
 
 func CmdCreateAudienceClaim() *cobra.Command {
@@ -69,10 +75,22 @@ func CmdCreateAudience() *cobra.Command {
 				admin = clientCtx.GetFromAddress().String()
 			}
 
+			issuer, err := cmd.Flags().GetString(FlagIssuer)
+			if err != nil {
+				return err
+			}
+
+			azp, err := cmd.Flags().GetString(FlagAzp)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateAudience(
 				admin,
 				indexAud,
 				argKey,
+				issuer,
+				azp,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -82,6 +100,8 @@ func CmdCreateAudience() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagIssuer, "", "required iss claim of the tokens, if any")
+	cmd.Flags().String(FlagAzp, "", "required azp claim of the tokens, if any")
 
 	return cmd
 }
@@ -111,11 +131,23 @@ func CmdUpdateAudience() *cobra.Command {
 				newAdmin = clientCtx.GetFromAddress().String()
 			}
 
+			issuer, err := cmd.Flags().GetString(FlagIssuer)
+			if err != nil {
+				return err
+			}
+
+			azp, err := cmd.Flags().GetString(FlagAzp)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateAudience(
 				clientCtx.GetFromAddress().String(),
 				newAdmin,
 				indexAud,
 				argKey,
+				issuer,
+				azp,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -126,6 +158,8 @@ func CmdUpdateAudience() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagNewAdmin, "", "address to provide as the new admin")
+	cmd.Flags().String(FlagIssuer, "", "required iss claim of the tokens, empty to accept any issuer")
+	cmd.Flags().String(FlagAzp, "", "required azp claim of the tokens, empty to accept any party")
 
 	return cmd
 }
@@ -158,3 +192,133 @@ func CmdDeleteAudience() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAddAudienceKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-audience-key [aud] [key] --not-before [rfc3339] --not-after [rfc3339]",
+		Short: "Add a key, a JWK with a kid, to the key set of an audience",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			notBefore, notAfter, err := getKeyWindowFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAudienceKey(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				notBefore,
+				notAfter,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addKeyWindowFlags(cmd)
+
+	return cmd
+}
+
+func CmdRemoveAudienceKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-audience-key [aud] [kid]",
+		Short: "Remove a key from the key set of an audience",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveAudienceKey(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetAudienceKeyWindow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-audience-key-window [aud] [kid] --not-before [rfc3339] --not-after [rfc3339]",
+		Short: "Schedule when a key of an audience is valid, without bounds it is always valid",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			notBefore, notAfter, err := getKeyWindowFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAudienceKeyWindow(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				notBefore,
+				notAfter,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addKeyWindowFlags(cmd)
+
+	return cmd
+}
+
+func addKeyWindowFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagNotBefore, "", "RFC 3339 time from which the key is valid")
+	cmd.Flags().String(FlagNotAfter, "", "RFC 3339 time from which the key is no longer valid")
+}
+
+func getKeyWindowFlags(cmd *cobra.Command) (notBefore, notAfter *time.Time, err error) {
+	if notBefore, err = getTimeFlag(cmd, FlagNotBefore); err != nil {
+		return nil, nil, err
+	}
+	if notAfter, err = getTimeFlag(cmd, FlagNotAfter); err != nil {
+		return nil, nil, err
+	}
+	return notBefore, notAfter, nil
+}
+
+func getTimeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	t = t.UTC()
+
+	return &t, nil
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+const FlagAuthority = "authority"
+
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [path/to/params.json]",
+		Short: "Update the jwk params",
+		Long: `Update the jwk params from a JSON file, all params must be supplied:
+{
+  "time_offset": "30000",
+  "deployment_gas": "10000",
+  "claim_deposit": [{"denom": "uxion", "amount": "1000000"}],
+  "claim_expiration": "2592000s"
+}
+The time offset is in nanoseconds, at most 10 minutes.
+When the signer is not the authority, the update is submitted as a governance
+proposal with --title, --summary and --deposit.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+				return fmt.Errorf("error decoding params: %w", err)
+			}
+
+			authority, err := cmd.Flags().GetString(FlagAuthority)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(authority, params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if clientCtx.GetFromAddress().String() == authority {
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			proposal, err := newUpdateParamsProposal(cmd, clientCtx.GetFromAddress(), msg)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAuthority, authtypes.NewModuleAddress(govtypes.ModuleName).String(), "address of the jwk authority")
+	cmd.Flags().String(govcli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govcli.FlagSummary, "", "summary of the proposal")
+	cmd.Flags().String(govcli.FlagMetadata, "", "metadata of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of the proposal")
+
+	return cmd
+}
+
+// newUpdateParamsProposal wraps msg in a governance proposal of proposer
+func newUpdateParamsProposal(cmd *cobra.Command, proposer sdk.AccAddress, msg sdk.Msg) (*govv1.MsgSubmitProposal, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return nil, fmt.Errorf("deposit: %w", err)
+	}
+
+	proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, proposer.String(), metadata, title, summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, proposal.ValidateBasic()
+}
@@ -13,8 +13,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.AudienceList {
 		k.SetAudience(ctx, elem)
 	}
+	// Set all the audience claims
+	for _, elem := range genState.AudienceClaimList {
+		k.SetAudienceClaim(ctx, elem.AudHash, types.AudienceClaim{
+			Signer:     elem.Signer,
+			Deposit:    elem.Deposit,
+			Expiration: elem.Expiration,
+		})
+	}
+	// Set all the consumed tokens
+	for _, elem := range genState.ConsumedJWTList {
+		k.SetConsumedJWT(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
-	k.SetParams(ctx, genState.Params)
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -23,6 +37,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.AudienceList = k.GetAllAudience(ctx)
+	genesis.AudienceClaimList = k.GetAllAudienceClaims(ctx)
+	genesis.ConsumedJWTList = k.GetAllConsumedJWTs(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
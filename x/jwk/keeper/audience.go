@@ -1,17 +1,29 @@
 package keeper
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/burnt-labs/xion/x/jwk/types"
 )
 
-func (k Keeper) SetAudienceClaim(ctx sdk.Context, hash []byte, signer sdk.AccAddress) {
+// SetAudienceClaim sets an audience claim and indexes it by expiration
+func (k Keeper) SetAudienceClaim(ctx sdk.Context, hash []byte, audClaim types.AudienceClaim) {
+	k.removeAudienceClaimExpiry(ctx, hash)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceClaimKeyPrefix))
-	audClaim := types.AudienceClaim{Signer: signer.String()}
 	b := k.cdc.MustMarshal(&audClaim)
 	store.Set(types.AudienceClaimKey(hash), b)
+
+	if audClaim.Expiration != nil {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceClaimExpiryKeyPrefix))
+		expiryStore.Set(types.AudienceClaimExpiryKey(*audClaim.Expiration, hash), hash)
+	}
 }
 
 func (k Keeper) GetAudienceClaim(ctx sdk.Context, hash []byte) (val types.AudienceClaim, found bool) {
@@ -33,19 +45,131 @@ func (k Keeper) RemoveAudienceClaim(
 	ctx sdk.Context,
 	audHash []byte,
 ) {
+	k.removeAudienceClaimExpiry(ctx, audHash)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceClaimKeyPrefix))
 	store.Delete(types.AudienceClaimKey(
 		audHash,
 	))
 }
 
-// SetAudience set a specific audience in the store from its index
+func (k Keeper) removeAudienceClaimExpiry(ctx sdk.Context, audHash []byte) {
+	claim, found := k.GetAudienceClaim(ctx, audHash)
+	if !found || claim.Expiration == nil {
+		return
+	}
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceClaimExpiryKeyPrefix))
+	expiryStore.Delete(types.AudienceClaimExpiryKey(*claim.Expiration, audHash))
+}
+
+// SetAudienceClaimUsed marks the claim of an audience as used, so it does not
+// expire, or as unused, so it expires after the claim expiration param
+func (k Keeper) SetAudienceClaimUsed(ctx sdk.Context, audHash []byte, used bool) {
+	claim, found := k.GetAudienceClaim(ctx, audHash)
+	if !found {
+		return
+	}
+
+	claim.Expiration = nil
+	if expiration := k.GetClaimExpiration(ctx); !used && expiration > 0 {
+		expiresAt := ctx.BlockTime().Add(expiration).UTC()
+		claim.Expiration = &expiresAt
+	}
+
+	k.SetAudienceClaim(ctx, audHash, claim)
+}
+
+// ExpireAudienceClaims removes the claims that stayed unused past their
+// expiration and sends their deposits to the community pool. A claim whose
+// deposit cannot be sent is kept and expires again after the claim
+// expiration, so the module always holds the deposits of its claims.
+func (k Keeper) ExpireAudienceClaims(ctx sdk.Context) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceClaimExpiryKeyPrefix))
+
+	end := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime()))
+	iterator := expiryStore.Iterator(nil, end)
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, audHash := range expired {
+		claim, _ := k.GetAudienceClaim(ctx, audHash)
+
+		if !claim.Deposit.IsZero() {
+			cacheCtx, write := ctx.CacheContext()
+			err := k.distrKeeper.FundCommunityPool(cacheCtx, claim.Deposit, authtypes.NewModuleAddress(types.ModuleName))
+			if err != nil {
+				// retried after another claim expiration rather than every
+				// block, its signer may still delete it for a refund
+				k.SetAudienceClaimUsed(ctx, audHash, false)
+				k.Logger(ctx).Error("failed to send expired claim deposit to the community pool", "aud_hash", fmt.Sprintf("%X", audHash), "error", err)
+
+				expiration := ""
+				if retried, _ := k.GetAudienceClaim(ctx, audHash); retried.Expiration != nil {
+					expiration = retried.Expiration.Format(time.RFC3339)
+				}
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypeAudienceClaimExpiryFailed,
+					sdk.NewAttribute(types.AttributeKeyAudHash, fmt.Sprintf("%X", audHash)),
+					sdk.NewAttribute(types.AttributeKeyExpiration, expiration),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				))
+				continue
+			}
+			write()
+		}
+		k.RemoveAudienceClaim(ctx, audHash)
+	}
+}
+
+// GetAllAudienceClaims returns all audience claims with the hash of their
+// audience
+func (k Keeper) GetAllAudienceClaims(ctx sdk.Context) (list []types.GenesisAudienceClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceClaimKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AudienceClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		// claim keys are the audience hash followed by a separator
+		key := iterator.Key()
+		audHash := append([]byte{}, key[:len(key)-1]...)
+		list = append(list, types.GenesisAudienceClaim{
+			AudHash:    audHash,
+			Signer:     val.Signer,
+			Deposit:    val.Deposit,
+			Expiration: val.Expiration,
+		})
+	}
+
+	return
+}
+
+// HasAudienceWithHash returns true if an audience hashes to audHash
+func (k Keeper) HasAudienceWithHash(ctx sdk.Context, audHash []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceHashKeyPrefix))
+	return store.Has(types.AudienceHashKey(audHash))
+}
+
+// SetAudience set a specific audience in the store from its index, and
+// indexes it by the hash of its aud
 func (k Keeper) SetAudience(ctx sdk.Context, audience types.Audience) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceKeyPrefix))
 	b := k.cdc.MustMarshal(&audience)
 	store.Set(types.AudienceKey(
 		audience.Aud,
 	), b)
+
+	audHash := sha256.Sum256([]byte(audience.Aud))
+	hashStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceHashKeyPrefix))
+	hashStore.Set(types.AudienceHashKey(audHash[:]), []byte(audience.Aud))
 }
 
 // GetAudience returns a audience from its index
@@ -75,6 +199,10 @@ func (k Keeper) RemoveAudience(
 	store.Delete(types.AudienceKey(
 		aud,
 	))
+
+	audHash := sha256.Sum256([]byte(aud))
+	hashStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AudienceHashKeyPrefix))
+	hashStore.Delete(types.AudienceHashKey(audHash[:]))
 }
 
 // GetAllAudience returns all audience
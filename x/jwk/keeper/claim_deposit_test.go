@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/x/jwk/keeper"
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+func TestAudienceClaimDeposit(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.JwkKeeper)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000))
+	params := types.DefaultParams()
+	params.ClaimDeposit = deposit
+	params.ClaimExpiration = time.Hour
+	require.NoError(t, app.JwkKeeper.SetParams(ctx, params))
+
+	admin := sdk.MustAccAddressFromBech32(testAdmin)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, admin, deposit.MulInt(sdk.NewInt(2))))
+
+	_, pub := newTestKey(t, "key-1")
+	audHash := sha256.Sum256([]byte(testAud))
+	unusedHash := sha256.Sum256([]byte("unused"))
+
+	// claims hold the deposit of their signer
+	_, err := msgServer.CreateAudienceClaim(goCtx, types.NewMsgCreateAudienceClaim(admin, audHash[:]))
+	require.NoError(t, err)
+	_, err = msgServer.CreateAudienceClaim(goCtx, types.NewMsgCreateAudienceClaim(admin, unusedHash[:]))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, admin).IsZero())
+	require.Equal(t, deposit.MulInt(sdk.NewInt(2)), app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+
+	// the signer must afford the deposit
+	_, err = msgServer.CreateAudienceClaim(goCtx, types.NewMsgCreateAudienceClaim(admin, make([]byte, 32)))
+	require.Error(t, err)
+
+	// claims used by an audience do not expire
+	_, err = msgServer.CreateAudience(goCtx, types.NewMsgCreateAudience(testAdmin, testAud, pub, "", ""))
+	require.NoError(t, err)
+
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	app.JwkKeeper.ExpireAudienceClaims(expiredCtx)
+
+	_, found := app.JwkKeeper.GetAudienceClaim(ctx, audHash[:])
+	require.True(t, found)
+	_, found = app.JwkKeeper.GetAudienceClaim(ctx, unusedHash[:])
+	require.False(t, found)
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(deposit...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, deposit, app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+
+	// a claim is refunded once its audience is deleted
+	_, err = msgServer.DeleteAudienceClaim(goCtx, types.NewMsgDeleteAudienceClaim(admin, audHash[:]))
+	require.Error(t, err)
+	_, err = msgServer.DeleteAudience(expiredCtx, types.NewMsgDeleteAudience(testAdmin, testAud))
+	require.NoError(t, err)
+	claim, found := app.JwkKeeper.GetAudienceClaim(ctx, audHash[:])
+	require.True(t, found)
+	require.True(t, expiredCtx.BlockTime().Add(time.Hour).Equal(*claim.Expiration))
+
+	_, err = msgServer.DeleteAudienceClaim(goCtx, types.NewMsgDeleteAudienceClaim(admin, audHash[:]))
+	require.NoError(t, err)
+	require.Equal(t, deposit, app.BankKeeper.GetAllBalances(ctx, admin))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+
+	// deleted claims no longer expire
+	app.JwkKeeper.ExpireAudienceClaims(ctx.WithBlockTime(ctx.BlockTime().Add(3 * time.Hour)))
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(deposit...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// a claim whose deposit cannot be sent is kept and retried later
+	expiration := ctx.BlockTime().Add(time.Hour)
+	app.JwkKeeper.SetAudienceClaim(ctx, unusedHash[:], types.AudienceClaim{Signer: testAdmin, Deposit: deposit, Expiration: &expiration})
+	failedCtx := expiredCtx.WithEventManager(sdk.NewEventManager())
+	app.JwkKeeper.ExpireAudienceClaims(failedCtx)
+	claim, found = app.JwkKeeper.GetAudienceClaim(ctx, unusedHash[:])
+	require.True(t, found)
+	require.Equal(t, deposit, claim.Deposit)
+	require.True(t, expiredCtx.BlockTime().Add(time.Hour).Equal(*claim.Expiration))
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(deposit...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.Len(t, failedCtx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeAudienceClaimExpiryFailed, failedCtx.EventManager().Events()[0].Type)
+
+	// and not retried before its new expiration
+	app.JwkKeeper.ExpireAudienceClaims(expiredCtx.WithBlockTime(expiredCtx.BlockTime().Add(time.Minute)))
+	retried, _ := app.JwkKeeper.GetAudienceClaim(ctx, unusedHash[:])
+	require.Equal(t, claim, retried)
+}
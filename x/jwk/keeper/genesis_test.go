@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/x/jwk"
+	"github.com/burnt-labs/xion/x/jwk/keeper"
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+func TestGenesisAudienceClaims(t *testing.T) {
+	key, pub := newTestKey(t, "key-1")
+	app, ctx, msgServer := setupAudience(t, pub)
+	goCtx := sdk.WrapSDKContext(ctx)
+	audHash := sha256.Sum256([]byte(testAud))
+
+	// claims of deleted audiences are exported too
+	unusedHash := sha256.Sum256([]byte("unused"))
+	_, err := msgServer.CreateAudienceClaim(goCtx, types.NewMsgCreateAudienceClaim(sdk.MustAccAddressFromBech32(testAdmin), unusedHash[:]))
+	require.NoError(t, err)
+
+	// the claim of an audience cannot be deleted while it exists
+	_, err = msgServer.DeleteAudienceClaim(goCtx, types.NewMsgDeleteAudienceClaim(sdk.MustAccAddressFromBech32(testAdmin), audHash[:]))
+	require.Error(t, err)
+
+	// and follows it to its new admin
+	newAdmin := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = msgServer.UpdateAudience(goCtx, types.NewMsgUpdateAudience(testAdmin, newAdmin, testAud, pub, "", ""))
+	require.NoError(t, err)
+	claim, found := app.JwkKeeper.GetAudienceClaim(ctx, audHash[:])
+	require.True(t, found)
+	require.Equal(t, newAdmin, claim.Signer)
+
+	// consumed tokens are exported until they expire
+	_, err = msgServer.ConsumeJWT(goCtx, types.NewMsgConsumeJWT(testAdmin, testAud, testSub, signTestToken(t, key, ctx.BlockTime(), nil)))
+	require.NoError(t, err)
+
+	genesis := jwk.ExportGenesis(ctx, app.JwkKeeper)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.AudienceList, 1)
+	require.Len(t, genesis.ConsumedJWTList, 1)
+	unusedClaim, found := app.JwkKeeper.GetAudienceClaim(ctx, unusedHash[:])
+	require.True(t, found)
+	require.True(t, ctx.BlockTime().Add(types.DefaultClaimExpiration).Equal(*unusedClaim.Expiration))
+	require.ElementsMatch(t, []types.GenesisAudienceClaim{
+		{AudHash: audHash[:], Signer: newAdmin},
+		{AudHash: unusedHash[:], Signer: testAdmin, Expiration: unusedClaim.Expiration},
+	}, genesis.AudienceClaimList)
+
+	restarted := xionapp.Setup(t)
+	restartedCtx := restarted.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	jwk.InitGenesis(restartedCtx, restarted.JwkKeeper, *genesis)
+	require.Equal(t, genesis, jwk.ExportGenesis(restartedCtx, restarted.JwkKeeper))
+}
+
+func TestMigrateAudienceClaims(t *testing.T) {
+	_, pub := newTestKey(t, "key-1")
+	app, ctx, _ := setupAudience(t, pub)
+	audHash := sha256.Sum256([]byte(testAud))
+	other := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// the admin of the audience changed without its claim
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000))
+	expiration := ctx.BlockTime().Add(time.Hour)
+	app.JwkKeeper.SetAudienceClaim(ctx, audHash[:], types.AudienceClaim{Signer: other, Deposit: deposit, Expiration: &expiration})
+	// the claim of another audience was deleted
+	app.JwkKeeper.SetAudience(ctx, types.Audience{Admin: other, Aud: "unclaimed", Key: pub})
+	require.Error(t, jwk.ExportGenesis(ctx, app.JwkKeeper).Validate())
+	// and audiences were not indexed by hash
+	unclaimedHash := sha256.Sum256([]byte("unclaimed"))
+	hashStore := prefix.NewStore(ctx.KVStore(app.GetKey(types.StoreKey)), types.KeyPrefix(types.AudienceHashKeyPrefix))
+	hashStore.Delete(types.AudienceHashKey(unclaimedHash[:]))
+	require.False(t, app.JwkKeeper.HasAudienceWithHash(ctx, unclaimedHash[:]))
+
+	migrator := keeper.NewMigrator(app.JwkKeeper, app.GetSubspace(types.ModuleName))
+	require.NoError(t, migrator.Migrate4To5(ctx))
+
+	genesis := jwk.ExportGenesis(ctx, app.JwkKeeper)
+	require.NoError(t, genesis.Validate())
+	require.True(t, app.JwkKeeper.HasAudienceWithHash(ctx, unclaimedHash[:]))
+	require.ElementsMatch(t, []types.GenesisAudienceClaim{
+		{AudHash: audHash[:], Signer: testAdmin, Deposit: deposit},
+		{AudHash: unclaimedHash[:], Signer: other},
+	}, genesis.AudienceClaimList)
+
+	// the claim no longer expires
+	app.JwkKeeper.ExpireAudienceClaims(ctx.WithBlockTime(expiration))
+	_, found := app.JwkKeeper.GetAudienceClaim(ctx, audHash[:])
+	require.True(t, found)
+}
@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/x/jwk/keeper"
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+const (
+	testAdmin = "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq"
+	testAud   = "project-test"
+	testSub   = "user-test"
+)
+
+// newTestKey returns an ES256 private key with kid and its public JWK
+func newTestKey(t *testing.T, kid string) (jwk.Key, string) {
+	raw, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	key, err := jwk.FromRaw(raw)
+	require.NoError(t, err)
+	require.NoError(t, key.Set(jwk.AlgorithmKey, jwa.ES256))
+	if kid != "" {
+		require.NoError(t, key.Set(jwk.KeyIDKey, kid))
+	}
+
+	publicKey, err := key.PublicKey()
+	require.NoError(t, err)
+	bz, err := json.Marshal(publicKey)
+	require.NoError(t, err)
+
+	return key, string(bz)
+}
+
+// signTestToken returns a token for the test audience and subject, valid
+// around now
+func signTestToken(t *testing.T, key jwk.Key, now time.Time, claims map[string]interface{}) string {
+	token := jwt.New()
+	require.NoError(t, token.Set(jwt.AudienceKey, testAud))
+	require.NoError(t, token.Set(jwt.SubjectKey, testSub))
+	require.NoError(t, token.Set(jwt.IssuedAtKey, now.Add(-time.Minute)))
+	require.NoError(t, token.Set(jwt.NotBeforeKey, now.Add(-time.Minute)))
+	require.NoError(t, token.Set(jwt.ExpirationKey, now.Add(time.Hour)))
+	for name, value := range claims {
+		require.NoError(t, token.Set(name, value))
+	}
+
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.ES256, key))
+	require.NoError(t, err)
+
+	return string(signed)
+}
+
+func setupAudience(t *testing.T, key string) (*xionapp.WasmApp, sdk.Context, types.MsgServer) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.JwkKeeper)
+
+	audHash := sha256.Sum256([]byte(testAud))
+	_, err := msgServer.CreateAudienceClaim(sdk.WrapSDKContext(ctx), types.NewMsgCreateAudienceClaim(sdk.MustAccAddressFromBech32(testAdmin), audHash[:]))
+	require.NoError(t, err)
+	_, err = msgServer.CreateAudience(sdk.WrapSDKContext(ctx), types.NewMsgCreateAudience(testAdmin, testAud, key, "", ""))
+	require.NoError(t, err)
+
+	return app, ctx, msgServer
+}
+
+func TestAudienceKeySet(t *testing.T) {
+	key1, pub1 := newTestKey(t, "key-1")
+	key2, pub2 := newTestKey(t, "key-2")
+
+	app, ctx, msgServer := setupAudience(t, pub1)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	validate := func(token string) error {
+		_, err := app.JwkKeeper.ValidateJWT(goCtx, &types.QueryValidateJWTRequest{Aud: testAud, Sub: testSub, SigBytes: token})
+		return err
+	}
+
+	token1 := signTestToken(t, key1, ctx.BlockTime(), nil)
+	token2 := signTestToken(t, key2, ctx.BlockTime(), nil)
+	require.NoError(t, validate(token1))
+	require.ErrorIs(t, validate(token2), types.ErrUnknownKeyID)
+
+	// a rotated key is added without invalidating the previous one
+	_, err := msgServer.AddAudienceKey(goCtx, types.NewMsgAddAudienceKey(testAdmin, testAud, pub2, nil, nil))
+	require.NoError(t, err)
+	require.NoError(t, validate(token1))
+	require.NoError(t, validate(token2))
+
+	_, err = msgServer.AddAudienceKey(goCtx, types.NewMsgAddAudienceKey(testAdmin, testAud, pub2, nil, nil))
+	require.ErrorIs(t, err, types.ErrInvalidJWK)
+	_, err = msgServer.AddAudienceKey(goCtx, types.NewMsgAddAudienceKey(testAdmin+"x", testAud, pub2, nil, nil))
+	require.Error(t, err)
+
+	// a token must be signed by the key its kid selects
+	forged, err := key1.Clone()
+	require.NoError(t, err)
+	require.NoError(t, forged.Set(jwk.KeyIDKey, "key-2"))
+	require.Error(t, validate(signTestToken(t, forged, ctx.BlockTime(), nil)))
+
+	_, err = msgServer.RemoveAudienceKey(goCtx, types.NewMsgRemoveAudienceKey(testAdmin, testAud, "key-1"))
+	require.NoError(t, err)
+	require.ErrorIs(t, validate(token1), types.ErrUnknownKeyID)
+	require.NoError(t, validate(token2))
+
+	_, err = msgServer.RemoveAudienceKey(goCtx, types.NewMsgRemoveAudienceKey(testAdmin, testAud, "key-1"))
+	require.ErrorIs(t, err, types.ErrUnknownKeyID)
+	_, err = msgServer.RemoveAudienceKey(goCtx, types.NewMsgRemoveAudienceKey(testAdmin, testAud, "key-2"))
+	require.ErrorIs(t, err, types.ErrInvalidJWK)
+}
+
+func TestAudienceKeyWithoutKid(t *testing.T) {
+	key, pub := newTestKey(t, "")
+	app, ctx, msgServer := setupAudience(t, pub)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// a single key without kid verifies tokens whatever their kid
+	tokenKey, err := key.Clone()
+	require.NoError(t, err)
+	require.NoError(t, tokenKey.Set(jwk.KeyIDKey, "provider-kid"))
+	_, err = app.JwkKeeper.ValidateJWT(goCtx, &types.QueryValidateJWTRequest{Aud: testAud, Sub: testSub, SigBytes: signTestToken(t, tokenKey, ctx.BlockTime(), nil)})
+	require.NoError(t, err)
+
+	// but it must get a kid before other keys join it
+	_, pub2 := newTestKey(t, "key-2")
+	_, err = msgServer.AddAudienceKey(goCtx, types.NewMsgAddAudienceKey(testAdmin, testAud, pub2, nil, nil))
+	require.ErrorIs(t, err, types.ErrInvalidJWK)
+}
+
+func TestAudienceKeyWindow(t *testing.T) {
+	key1, pub1 := newTestKey(t, "key-1")
+	key2, pub2 := newTestKey(t, "key-2")
+
+	app, ctx, msgServer := setupAudience(t, pub1)
+	start := ctx.BlockTime()
+
+	validate := func(ctx sdk.Context, key jwk.Key) error {
+		token := signTestToken(t, key, ctx.BlockTime(), nil)
+		_, err := app.JwkKeeper.ValidateJWT(sdk.WrapSDKContext(ctx), &types.QueryValidateJWTRequest{Aud: testAud, Sub: testSub, SigBytes: token})
+		return err
+	}
+
+	// the next key is staged ahead of the rotation, the current one retired
+	notBefore := start.Add(time.Hour)
+	msg := types.NewMsgAddAudienceKey(testAdmin, testAud, pub2, &notBefore, nil)
+	require.NoError(t, msg.ValidateBasic())
+	_, err := msgServer.AddAudienceKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	notAfter := start.Add(90 * time.Minute)
+	setMsg := types.NewMsgSetAudienceKeyWindow(testAdmin, testAud, "key-1", nil, &notAfter)
+	require.NoError(t, setMsg.ValidateBasic())
+	_, err = msgServer.SetAudienceKeyWindow(sdk.WrapSDKContext(ctx), setMsg)
+	require.NoError(t, err)
+
+	require.NoError(t, validate(ctx, key1))
+	require.ErrorIs(t, validate(ctx, key2), types.ErrKeyNotActive)
+
+	overlap := ctx.WithBlockTime(start.Add(75 * time.Minute))
+	require.NoError(t, validate(overlap, key1))
+	require.NoError(t, validate(overlap, key2))
+
+	later := ctx.WithBlockTime(start.Add(2 * time.Hour))
+	require.ErrorIs(t, validate(later, key1), types.ErrKeyNotActive)
+	require.NoError(t, validate(later, key2))
+
+	// an unbounded window makes the key valid at all times
+	_, err = msgServer.SetAudienceKeyWindow(sdk.WrapSDKContext(ctx), types.NewMsgSetAudienceKeyWindow(testAdmin, testAud, "key-1", nil, nil))
+	require.NoError(t, err)
+	require.NoError(t, validate(later, key1))
+
+	_, err = msgServer.SetAudienceKeyWindow(sdk.WrapSDKContext(ctx), types.NewMsgSetAudienceKeyWindow(testAdmin, testAud, "key-3", nil, &notAfter))
+	require.ErrorIs(t, err, types.ErrUnknownKeyID)
+	require.ErrorIs(t, types.NewMsgSetAudienceKeyWindow(testAdmin, testAud, "key-1", &notAfter, &notBefore).ValidateBasic(), types.ErrInvalidKeyWindow)
+
+	// windows of keys dropped by an update are removed
+	_, err = msgServer.UpdateAudience(sdk.WrapSDKContext(ctx), types.NewMsgUpdateAudience(testAdmin, testAdmin, testAud, pub1, "", ""))
+	require.NoError(t, err)
+	audience, found := app.JwkKeeper.GetAudience(ctx, testAud)
+	require.True(t, found)
+	require.Empty(t, audience.KeyWindows)
+}
+
+func TestValidateJWTPrivateClaims(t *testing.T) {
+	key, pub := newTestKey(t, "key-1")
+	app, ctx, _ := setupAudience(t, pub)
+
+	token := signTestToken(t, key, ctx.BlockTime(), map[string]interface{}{
+		"email":          "user@burnt.com",
+		"email_verified": true,
+		"groups":         []string{"admin", "dev"},
+		"address":        map[string]interface{}{"country": "US", "zip": 10001},
+	})
+
+	res, err := app.JwkKeeper.ValidateJWT(sdk.WrapSDKContext(ctx), &types.QueryValidateJWTRequest{Aud: testAud, Sub: testSub, SigBytes: token})
+	require.NoError(t, err)
+	require.Equal(t, []*types.PrivateClaim{
+		{Key: "address", Value: `{"country":"US","zip":10001}`, Type: types.ClaimTypeObject, JsonValue: `{"country":"US","zip":10001}`},
+		{Key: "email", Value: "user@burnt.com", Type: types.ClaimTypeString, JsonValue: `"user@burnt.com"`},
+		{Key: "email_verified", Value: "true", Type: types.ClaimTypeBoolean, JsonValue: "true"},
+		{Key: "groups", Value: `["admin","dev"]`, Type: types.ClaimTypeArray, JsonValue: `["admin","dev"]`},
+	}, res.PrivateClaims)
+}
+
+func TestAudienceIssuer(t *testing.T) {
+	key, pub := newTestKey(t, "key-1")
+	app, ctx, msgServer := setupAudience(t, pub)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	validate := func(claims map[string]interface{}) (*types.QueryValidateJWTResponse, error) {
+		token := signTestToken(t, key, ctx.BlockTime(), claims)
+		return app.JwkKeeper.ValidateJWT(goCtx, &types.QueryValidateJWTRequest{Aud: testAud, Sub: testSub, SigBytes: token})
+	}
+
+	// without an issuer any token passes
+	_, err := validate(map[string]interface{}{jwt.IssuerKey: "https://other.test"})
+	require.NoError(t, err)
+
+	_, err = msgServer.UpdateAudience(goCtx, types.NewMsgUpdateAudience(testAdmin, testAdmin, testAud, pub, "https://issuer.test", "client-test"))
+	require.NoError(t, err)
+
+	_, err = validate(nil)
+	require.Error(t, err)
+	_, err = validate(map[string]interface{}{jwt.IssuerKey: "https://other.test", types.ClaimAuthorizedParty: "client-test"})
+	require.Error(t, err)
+	_, err = validate(map[string]interface{}{jwt.IssuerKey: "https://issuer.test"})
+	require.Error(t, err)
+	_, err = validate(map[string]interface{}{jwt.IssuerKey: "https://issuer.test", types.ClaimAuthorizedParty: "other-client"})
+	require.Error(t, err)
+
+	res, err := validate(map[string]interface{}{
+		jwt.IssuerKey:              "https://issuer.test",
+		jwt.JwtIDKey:               "token-1",
+		types.ClaimAuthorizedParty: "client-test",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://issuer.test", res.Iss)
+	require.Equal(t, "token-1", res.Jti)
+	require.Equal(t, ctx.BlockTime().Add(-time.Minute).Unix(), res.Iat)
+	require.Equal(t, ctx.BlockTime().Add(-time.Minute).Unix(), res.Nbf)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour).Unix(), res.Exp)
+	require.Equal(t, []*types.PrivateClaim{
+		{Key: types.ClaimAuthorizedParty, Value: "client-test", Type: types.ClaimTypeString, JsonValue: `"client-test"`},
+	}, res.PrivateClaims)
+
+	// updating the audience without an issuer lifts the binding
+	_, err = msgServer.UpdateAudience(goCtx, types.NewMsgUpdateAudience(testAdmin, testAdmin, testAud, pub, "", ""))
+	require.NoError(t, err)
+	_, err = validate(nil)
+	require.NoError(t, err)
+}
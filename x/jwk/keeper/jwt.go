@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+// ConsumeJWT validates a token like the ValidateJWT query and records it for
+// signer until it expires. A token consumed by signer is rejected until then,
+// so it authenticates a single transaction. Tokens must have an expiration.
+func (k Keeper) ConsumeJWT(ctx sdk.Context, signer sdk.AccAddress, aud, sub, sigBytes string) (*types.ConsumedJWT, *types.QueryValidateJWTResponse, error) {
+	token, res, err := k.validateJWT(ctx, aud, sub, sigBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if token.Expiration().IsZero() {
+		return nil, nil, types.ErrJWTNoExpiration
+	}
+
+	consumed := types.ConsumedJWT{
+		Signer:     signer.String(),
+		Aud:        aud,
+		TokenId:    tokenID(token.JwtID(), sigBytes),
+		Expiration: token.Expiration().UTC(),
+	}
+
+	if k.HasConsumedJWT(ctx, signer, consumed.Aud, consumed.TokenId) {
+		return nil, nil, errorsmod.Wrapf(types.ErrJWTConsumed, "token %s", consumed.TokenId)
+	}
+
+	k.SetConsumedJWT(ctx, consumed)
+
+	return &consumed, res, nil
+}
+
+// tokenID returns the jti of a token or, when it has none, the hash of its
+// signing input. The signature is left out as it may be malleable.
+func tokenID(jti, sigBytes string) string {
+	if jti != "" {
+		return jti
+	}
+
+	signingInput := sigBytes
+	if i := strings.LastIndex(sigBytes, "."); i >= 0 {
+		signingInput = sigBytes[:i]
+	}
+	hash := sha256.Sum256([]byte(signingInput))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// SetConsumedJWT records a consumed token and indexes it by expiration
+func (k Keeper) SetConsumedJWT(ctx sdk.Context, consumed types.ConsumedJWT) {
+	key := types.ConsumedJWTKey(sdk.MustAccAddressFromBech32(consumed.Signer), consumed.Aud, consumed.TokenId)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumedJWTKeyPrefix))
+	store.Set(key, k.cdc.MustMarshal(&consumed))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumedJWTExpiryKeyPrefix))
+	expiryStore.Set(types.ConsumedJWTExpiryKey(consumed.Expiration, key), key)
+}
+
+// HasConsumedJWT returns true if signer consumed the token with tokenID
+func (k Keeper) HasConsumedJWT(ctx sdk.Context, signer sdk.AccAddress, aud, tokenID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumedJWTKeyPrefix))
+	return store.Has(types.ConsumedJWTKey(signer, aud, tokenID))
+}
+
+// GetAllConsumedJWTs returns all consumed tokens that were not pruned yet
+func (k Keeper) GetAllConsumedJWTs(ctx sdk.Context) (list []types.ConsumedJWT) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumedJWTKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ConsumedJWT
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneConsumedJWTs removes the consumed tokens that expired, they fail
+// validation anyway
+func (k Keeper) PruneConsumedJWTs(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumedJWTKeyPrefix))
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ConsumedJWTExpiryKeyPrefix))
+
+	// tokens are expired once the block time reaches their expiration. The
+	// validation time is ahead by the time offset, which governance may lower
+	// and make a token valid again, so it cannot be pruned against.
+	end := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime()))
+	iterator := expiryStore.Iterator(nil, end)
+
+	var expiryKeys, keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiryKeys = append(expiryKeys, iterator.Key())
+		keys = append(keys, iterator.Value())
+	}
+	iterator.Close()
+
+	for i := range keys {
+		store.Delete(keys[i])
+		expiryStore.Delete(expiryKeys[i])
+	}
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+func TestConsumeJWT(t *testing.T) {
+	key, pub := newTestKey(t, "key-1")
+	app, ctx, msgServer := setupAudience(t, pub)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	account := sdk.AccAddress([]byte("account_____________")).String()
+	other := sdk.AccAddress([]byte("other_______________")).String()
+
+	consume := func(signer, token string) (*types.MsgConsumeJWTResponse, error) {
+		return msgServer.ConsumeJWT(goCtx, types.NewMsgConsumeJWT(signer, testAud, testSub, token))
+	}
+
+	token := signTestToken(t, key, ctx.BlockTime(), map[string]interface{}{jwt.JwtIDKey: "token-1"})
+	res, err := consume(account, token)
+	require.NoError(t, err)
+	require.Equal(t, "token-1", res.Consumed.TokenId)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour).Unix(), res.Consumed.Expiration.Unix())
+
+	// the token and any other with its jti are single use
+	_, err = consume(account, token)
+	require.ErrorIs(t, err, types.ErrJWTConsumed)
+	_, err = consume(account, signTestToken(t, key, ctx.BlockTime().Add(time.Second), map[string]interface{}{jwt.JwtIDKey: "token-1"}))
+	require.ErrorIs(t, err, types.ErrJWTConsumed)
+
+	// consumption is per signer, so others cannot burn the token of an account
+	_, err = consume(other, token)
+	require.NoError(t, err)
+
+	// the query still validates consumed tokens
+	_, err = app.JwkKeeper.ValidateJWT(goCtx, &types.QueryValidateJWTRequest{Aud: testAud, Sub: testSub, SigBytes: token})
+	require.NoError(t, err)
+
+	// tokens without jti are identified by their signing input
+	noJti := signTestToken(t, key, ctx.BlockTime(), nil)
+	res, err = consume(account, noJti)
+	require.NoError(t, err)
+	require.Len(t, res.Consumed.TokenId, 64)
+	_, err = consume(account, noJti)
+	require.ErrorIs(t, err, types.ErrJWTConsumed)
+
+	// invalid tokens are not consumed
+	_, err = consume(account, signTestToken(t, key, ctx.BlockTime().Add(-2*time.Hour), map[string]interface{}{jwt.JwtIDKey: "token-2"}))
+	require.Error(t, err)
+	require.False(t, app.JwkKeeper.HasConsumedJWT(ctx, sdk.MustAccAddressFromBech32(account), testAud, "token-2"))
+
+	require.Len(t, app.JwkKeeper.GetAllConsumedJWTs(ctx), 3)
+
+	// consumed tokens are pruned once they expire
+	app.JwkKeeper.PruneConsumedJWTs(ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute)))
+	require.Len(t, app.JwkKeeper.GetAllConsumedJWTs(ctx), 3)
+	app.JwkKeeper.PruneConsumedJWTs(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	require.Empty(t, app.JwkKeeper.GetAllConsumedJWTs(ctx))
+}
+
+func TestPruneConsumedJWTsTimeOffset(t *testing.T) {
+	key, pub := newTestKey(t, "key-1")
+	app, ctx, msgServer := setupAudience(t, pub)
+
+	account := sdk.AccAddress([]byte("account_____________")).String()
+	params := app.JwkKeeper.GetParams(ctx)
+	params.TimeOffset = types.MaxTimeOffset
+	require.NoError(t, app.JwkKeeper.SetParams(ctx, params))
+
+	token := signTestToken(t, key, ctx.BlockTime(), map[string]interface{}{jwt.JwtIDKey: "token-1"})
+	_, err := msgServer.ConsumeJWT(sdk.WrapSDKContext(ctx), types.NewMsgConsumeJWT(account, testAud, testSub, token))
+	require.NoError(t, err)
+
+	// the token is expired at the validation time but not at the block time
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(55 * time.Minute))
+	app.JwkKeeper.PruneConsumedJWTs(later)
+	require.Len(t, app.JwkKeeper.GetAllConsumedJWTs(later), 1)
+
+	// lowering the offset makes the token valid again, it is still consumed
+	params.TimeOffset = 0
+	require.NoError(t, app.JwkKeeper.SetParams(later, params))
+	_, err = msgServer.ConsumeJWT(sdk.WrapSDKContext(later), types.NewMsgConsumeJWT(account, testAud, testSub, token))
+	require.ErrorIs(t, err, types.ErrJWTConsumed)
+}
+
+func TestConsumeJWTWithoutExpiration(t *testing.T) {
+	key, pub := newTestKey(t, "key-1")
+	app, ctx, _ := setupAudience(t, pub)
+
+	token := jwt.New()
+	require.NoError(t, token.Set(jwt.AudienceKey, testAud))
+	require.NoError(t, token.Set(jwt.SubjectKey, testSub))
+	signed, err := jwt.Sign(token, jwt.WithKey(key.Algorithm(), key))
+	require.NoError(t, err)
+
+	_, _, err = app.JwkKeeper.ConsumeJWT(ctx, sdk.AccAddress([]byte("account_____________")), testAud, testSub, string(signed))
+	require.ErrorIs(t, err, types.ErrJWTNoExpiration)
+}
@@ -5,19 +5,39 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	v1 "github.com/burnt-labs/xion/x/jwk/migrations/v1"
+	v2 "github.com/burnt-labs/xion/x/jwk/migrations/v2"
+	v3 "github.com/burnt-labs/xion/x/jwk/migrations/v3"
+	v4 "github.com/burnt-labs/xion/x/jwk/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
+	keeper      Keeper
 	jwkSubspace paramtypes.Subspace
 }
 
-// NewMigrator returns a new Migrator.
-func NewMigrator(jwkSubspace paramtypes.Subspace) Migrator {
-	return Migrator{jwkSubspace}
+// NewMigrator returns a new Migrator. The legacy subspace is only read to
+// migrate the params to the jwk store.
+func NewMigrator(keeper Keeper, jwkSubspace paramtypes.Subspace) Migrator {
+	return Migrator{keeper: keeper, jwkSubspace: jwkSubspace}
 }
 
 // Migrate1To2 migrates from version 1 to 2
 func (m Migrator) Migrate1To2(ctx sdk.Context) error {
 	return v1.MigrateStore(ctx, m.jwkSubspace)
 }
+
+// Migrate2To3 migrates from version 2 to 3
+func (m Migrator) Migrate2To3(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.jwkSubspace)
+}
+
+// Migrate3To4 migrates from version 3 to 4
+func (m Migrator) Migrate3To4(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeKey, m.jwkSubspace, m.keeper.cdc)
+}
+
+// Migrate4To5 migrates from version 4 to 5
+func (m Migrator) Migrate4To5(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
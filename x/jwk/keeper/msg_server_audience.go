@@ -32,7 +32,18 @@ func (k msgServer) CreateAudienceClaim(goCtx context.Context, msg *types.MsgCrea
 	if err != nil {
 		return nil, err
 	}
-	k.SetAudienceClaim(ctx, msg.AudHash, addr)
+
+	// the deposit is refunded when the claim is deleted
+	deposit := k.GetClaimDeposit(ctx)
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, deposit); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to pay the audience claim deposit")
+		}
+	}
+
+	k.SetAudienceClaim(ctx, msg.AudHash, types.AudienceClaim{Signer: addr.String(), Deposit: deposit})
+	// the claim expires unless an audience uses it
+	k.SetAudienceClaimUsed(ctx, msg.AudHash, false)
 
 	return &types.MsgCreateAudienceClaimResponse{}, nil
 }
@@ -54,6 +65,18 @@ func (k msgServer) DeleteAudienceClaim(goCtx context.Context, msg *types.MsgDele
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// the claim of an existing audience must stay with its admin
+	if k.HasAudienceWithHash(ctx, msg.AudHash) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "audience still exists, delete it first")
+	}
+
+	if !valFound.Deposit.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(valFound.Signer), valFound.Deposit)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to refund the audience claim deposit")
+		}
+	}
+
 	k.RemoveAudienceClaim(
 		ctx,
 		msg.AudHash,
@@ -86,15 +109,19 @@ func (k msgServer) CreateAudience(goCtx context.Context, msg *types.MsgCreateAud
 	}
 
 	audience := types.Audience{
-		Admin: msg.Admin,
-		Aud:   msg.Aud,
-		Key:   msg.Key,
+		Admin:           msg.Admin,
+		Aud:             msg.Aud,
+		Key:             msg.Key,
+		Issuer:          msg.Issuer,
+		AuthorizedParty: msg.AuthorizedParty,
 	}
 
 	k.SetAudience(
 		ctx,
 		audience,
 	)
+	k.SetAudienceClaimUsed(ctx, audHash[:], true)
+
 	return &types.MsgCreateAudienceResponse{Audience: &audience}, nil
 }
 
@@ -115,11 +142,20 @@ func (k msgServer) UpdateAudience(goCtx context.Context, msg *types.MsgUpdateAud
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	// updates based on new values provided, potentially admin, aud and key
+	// updates based on new values provided, potentially admin, aud, key,
+	// issuer and authorized party
 	audience := types.Audience{
-		Admin: msg.NewAdmin,
-		Aud:   msg.Aud,
-		Key:   msg.Key,
+		Admin:           msg.NewAdmin,
+		Aud:             msg.Aud,
+		Key:             msg.Key,
+		KeyWindows:      valFound.KeyWindows,
+		Issuer:          msg.Issuer,
+		AuthorizedParty: msg.AuthorizedParty,
+	}
+
+	// windows of keys that are still part of the audience are kept
+	if err := audience.PruneKeyWindows(); err != nil {
+		return nil, err
 	}
 
 	// if changing the aud, make sure a claim exists under this admin, and that it won't override
@@ -145,6 +181,20 @@ func (k msgServer) UpdateAudience(goCtx context.Context, msg *types.MsgUpdateAud
 		}
 
 		k.RemoveAudience(ctx, valFound.Aud)
+
+		// the claim of the former aud now expires unless used again
+		oldHash := sha256.Sum256([]byte(valFound.Aud))
+		k.SetAudienceClaimUsed(ctx, oldHash[:], false)
+		k.SetAudienceClaimUsed(ctx, audHash[:], true)
+	}
+
+	// the claim, and the refund of its deposit, follow the audience to its
+	// new admin
+	if audience.Admin != msg.Admin {
+		audHash := sha256.Sum256([]byte(audience.Aud))
+		claim, _ := k.GetAudienceClaim(ctx, audHash[:])
+		claim.Signer = audience.Admin
+		k.SetAudienceClaim(ctx, audHash[:], claim)
 	}
 
 	k.SetAudience(ctx, audience)
@@ -174,5 +224,92 @@ func (k msgServer) DeleteAudience(goCtx context.Context, msg *types.MsgDeleteAud
 		msg.Aud,
 	)
 
+	// the claim stays with the admin, and expires unless used again
+	audHash := sha256.Sum256([]byte(msg.Aud))
+	k.SetAudienceClaimUsed(ctx, audHash[:], false)
+
 	return &types.MsgDeleteAudienceResponse{}, nil
 }
+
+func (k msgServer) AddAudienceKey(goCtx context.Context, msg *types.MsgAddAudienceKey) (*types.MsgAddAudienceKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	audience, isFound := k.GetAudience(ctx, msg.Aud)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+	}
+
+	// Checks if the msg admin is the same as the current owner
+	if msg.Admin != audience.Admin {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	keys, err := types.AddAudienceKey(audience.Key, msg.Key)
+	if err != nil {
+		return nil, err
+	}
+	audience.Key = keys
+
+	key, err := types.ParseAudienceKey(msg.Key)
+	if err != nil {
+		return nil, err
+	}
+	audience.SetKeyWindow(types.NewKeyWindow(key.KeyID(), msg.NotBefore, msg.NotAfter))
+
+	k.SetAudience(ctx, audience)
+
+	return &types.MsgAddAudienceKeyResponse{Audience: &audience}, nil
+}
+
+func (k msgServer) RemoveAudienceKey(goCtx context.Context, msg *types.MsgRemoveAudienceKey) (*types.MsgRemoveAudienceKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	audience, isFound := k.GetAudience(ctx, msg.Aud)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+	}
+
+	// Checks if the msg admin is the same as the current owner
+	if msg.Admin != audience.Admin {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	keys, err := types.RemoveAudienceKey(audience.Key, msg.Kid)
+	if err != nil {
+		return nil, err
+	}
+	audience.Key = keys
+	audience.SetKeyWindow(types.NewKeyWindow(msg.Kid, nil, nil))
+
+	k.SetAudience(ctx, audience)
+
+	return &types.MsgRemoveAudienceKeyResponse{Audience: &audience}, nil
+}
+
+func (k msgServer) SetAudienceKeyWindow(goCtx context.Context, msg *types.MsgSetAudienceKeyWindow) (*types.MsgSetAudienceKeyWindowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	audience, isFound := k.GetAudience(ctx, msg.Aud)
+	if !isFound {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+	}
+
+	// Checks if the msg admin is the same as the current owner
+	if msg.Admin != audience.Admin {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	keys, err := types.ParseAudienceKeys(audience.Key)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := keys.LookupKeyID(msg.Kid); !found {
+		return nil, errorsmod.Wrapf(types.ErrUnknownKeyID, "kid %s", msg.Kid)
+	}
+
+	audience.SetKeyWindow(types.NewKeyWindow(msg.Kid, msg.NotBefore, msg.NotAfter))
+
+	k.SetAudience(ctx, audience)
+
+	return &types.MsgSetAudienceKeyWindowResponse{Audience: &audience}, nil
+}
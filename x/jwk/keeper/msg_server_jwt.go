@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+func (k msgServer) ConsumeJWT(goCtx context.Context, msg *types.MsgConsumeJWT) (*types.MsgConsumeJWTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	consumed, _, err := k.Keeper.ConsumeJWT(ctx, signer, msg.Aud, msg.Sub, msg.SigBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConsumeJWTResponse{Consumed: consumed}, nil
+}
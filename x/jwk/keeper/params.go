@@ -1,29 +1,49 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/burnt-labs/xion/x/jwk/types"
 )
 
-// GetParams get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetTimeOffset(ctx), k.GetDeploymentGas(ctx))
+// GetParams returns the current x/jwk module parameters
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
 }
 
-// SetParams set the params
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramspace.SetParamSet(ctx, &params)
+// SetParams sets the x/jwk module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+
+	return nil
 }
 
 func (k Keeper) GetTimeOffset(ctx sdk.Context) uint64 {
-	var to uint64
-	k.paramspace.Get(ctx, types.ParamStoreKeyTimeOffset, &to)
-	return to
+	return k.GetParams(ctx).TimeOffset
 }
 
 func (k Keeper) GetDeploymentGas(ctx sdk.Context) uint64 {
-	var dg uint64
-	k.paramspace.Get(ctx, types.ParamStoreKeyDeploymentGas, &dg)
-	return dg
+	return k.GetParams(ctx).DeploymentGas
+}
+
+func (k Keeper) GetClaimDeposit(ctx sdk.Context) sdk.Coins {
+	return k.GetParams(ctx).ClaimDeposit
+}
+
+func (k Keeper) GetClaimExpiration(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).ClaimExpiration
 }
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/x/jwk/keeper"
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.JwkKeeper)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	require.Equal(t, authority, app.JwkKeeper.GetAuthority())
+
+	params := types.DefaultParams()
+	params.TimeOffset = uint64(5 * time.Second)
+	params.ClaimDeposit = sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000))
+
+	// only the authority updates the params
+	_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(testAdmin, params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, app.JwkKeeper.GetParams(ctx))
+	require.Equal(t, uint64(5*time.Second), app.JwkKeeper.GetTimeOffset(ctx))
+
+	// the time offset is bounded
+	params.TimeOffset = types.MaxTimeOffset + 1
+	require.Error(t, types.NewMsgUpdateParams(authority, params).ValidateBasic())
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(authority, params))
+	require.Error(t, err)
+	require.Equal(t, uint64(5*time.Second), app.JwkKeeper.GetTimeOffset(ctx))
+}
+
+func TestMigrateParamsToStore(t *testing.T) {
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+
+	legacyParams := types.NewParams(uint64(time.Second), 20_000, sdk.NewCoins(sdk.NewInt64Coin("uxion", 1000)), time.Hour)
+	subspace := app.GetSubspace(types.ModuleName).WithKeyTable(types.ParamKeyTable())
+	subspace.SetParamSet(ctx, &legacyParams)
+
+	migrator := keeper.NewMigrator(app.JwkKeeper, subspace)
+	require.NoError(t, migrator.Migrate3To4(ctx))
+	require.Equal(t, legacyParams, app.JwkKeeper.GetParams(ctx))
+}
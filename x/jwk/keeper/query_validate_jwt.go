@@ -2,10 +2,9 @@ package keeper
 
 import (
 	"context"
-	"sort"
 	"time"
 
-	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,47 +21,94 @@ func (k Keeper) ValidateJWT(goCtx context.Context, req *types.QueryValidateJWTRe
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	audience, exists := k.GetAudience(ctx, req.Aud)
+	_, res, err := k.validateJWT(ctx, req.Aud, req.Sub, req.SigBytes)
+	return res, err
+}
+
+// validateJWT verifies a token for an audience and subject, and returns it
+// with the claims reported by ValidateJWT
+func (k Keeper) validateJWT(ctx sdk.Context, aud, sub, sigBytes string) (jwt.Token, *types.QueryValidateJWTResponse, error) {
+	audience, exists := k.GetAudience(ctx, aud)
 	if !exists {
-		return nil, status.Error(codes.NotFound, "not found")
+		return nil, nil, status.Error(codes.NotFound, "not found")
 	}
 
-	key, err := jwk.ParseKey([]byte(audience.Key))
+	keys, err := types.ParseAudienceKeys(audience.Key)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	token, err := jwt.Parse([]byte(req.SigBytes),
+	key, err := types.SelectAudienceKey(keys, []byte(sigBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := k.jwtTime(ctx)
+
+	if window, found := audience.GetKeyWindow(key.KeyID()); found {
+		if err := window.Check(now); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	options := []jwt.ParseOption{
 		jwt.WithKey(key.Algorithm(), key),
-		jwt.WithAudience(req.Aud),
-		jwt.WithSubject(req.Sub),
+		jwt.WithAudience(aud),
+		jwt.WithSubject(sub),
 		jwt.WithClock(jwt.ClockFunc(func() time.Time {
-			// adjust the time from the block-height due to lagging reported time
-			return ctx.BlockTime().Add(time.Duration(k.GetTimeOffset(ctx)))
+			return now
 		})),
 		jwt.WithValidate(true),
-	)
+	}
+	if audience.Issuer != "" {
+		options = append(options, jwt.WithIssuer(audience.Issuer))
+	}
+	if audience.AuthorizedParty != "" {
+		options = append(options, jwt.WithClaimValue(types.ClaimAuthorizedParty, audience.AuthorizedParty))
+	}
+
+	token, err := jwt.Parse([]byte(sigBytes), options...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+
+	msg, err := jws.Parse([]byte(sigBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// returning maps in protobufs can get hairy, we return a list instead
-	privateClaimsMap := token.PrivateClaims()
-	privateClaims := make([]*types.PrivateClaim, len(privateClaimsMap))
-
-	i := 0
-	for k, v := range privateClaimsMap {
-		privateClaims[i] = &types.PrivateClaim{
-			Key:   k,
-			Value: v.(string),
-		}
-		i++
+	claimKeys := make([]string, 0, len(token.PrivateClaims()))
+	for key := range token.PrivateClaims() {
+		claimKeys = append(claimKeys, key)
 	}
-	// even though there should be no duplicates, sort this deterministically
-	sort.SliceStable(privateClaims, func(i, j int) bool {
-		return privateClaims[i].Key < privateClaims[j].Key
-	})
 
-	return &types.QueryValidateJWTResponse{
+	privateClaims, err := types.NewPrivateClaims(msg.Payload(), claimKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return token, &types.QueryValidateJWTResponse{
 		PrivateClaims: privateClaims,
+		Iss:           token.Issuer(),
+		Iat:           unixTime(token.IssuedAt()),
+		Exp:           unixTime(token.Expiration()),
+		Nbf:           unixTime(token.NotBefore()),
+		Jti:           token.JwtID(),
 	}, nil
 }
+
+// jwtTime returns the time tokens are validated at, the block time adjusted
+// by the time offset due to lagging reported time
+func (k Keeper) jwtTime(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(time.Duration(k.GetTimeOffset(ctx)))
+}
+
+// unixTime returns the unix seconds of a claim time, zero when the claim is
+// absent
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,27 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+// MigrateStore performs in-place params migrations, setting the default
+// ClaimDeposit and ClaimExpiration and keeping the other params
+//
+// existing claims hold no deposit and never expire, until their audience is
+// deleted
+func MigrateStore(ctx sdk.Context, jwkSubspace paramtypes.Subspace) error {
+	ctx.Logger().Info("Running jwk migration to v3")
+
+	if !jwkSubspace.HasKeyTable() {
+		jwkSubspace = jwkSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	defaultParams := types.DefaultParams()
+	jwkSubspace.Set(ctx, types.ParamStoreKeyClaimDeposit, defaultParams.ClaimDeposit)
+	jwkSubspace.Set(ctx, types.ParamStoreKeyClaimExpiration, defaultParams.ClaimExpiration)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+// MigrateStore performs in-place params migrations, moving the params from
+// the legacy x/params subspace to the jwk store
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, jwkSubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Running jwk migration to v4")
+
+	if !jwkSubspace.HasKeyTable() {
+		jwkSubspace = jwkSubspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	var params types.Params
+	jwkSubspace.GetParamSet(ctx, &params)
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
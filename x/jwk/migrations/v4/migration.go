@@ -0,0 +1,60 @@
+package v4
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+// MigrateStore reconciles the audience claims with their audiences. Updating
+// the admin of an audience used to leave its claim with the former admin, and
+// the claim of an audience could be deleted. Every audience now gets a claim
+// owned by its admin that does not expire, and audiences are indexed by the
+// hash of their aud.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Running jwk migration to v5")
+
+	store := ctx.KVStore(storeKey)
+	audienceStore := prefix.NewStore(store, types.KeyPrefix(types.AudienceKeyPrefix))
+	claimStore := prefix.NewStore(store, types.KeyPrefix(types.AudienceClaimKeyPrefix))
+	expiryStore := prefix.NewStore(store, types.KeyPrefix(types.AudienceClaimExpiryKeyPrefix))
+	hashStore := prefix.NewStore(store, types.KeyPrefix(types.AudienceHashKeyPrefix))
+
+	var audiences []types.Audience
+	iterator := sdk.KVStorePrefixIterator(audienceStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var audience types.Audience
+		cdc.MustUnmarshal(iterator.Value(), &audience)
+		audiences = append(audiences, audience)
+	}
+	iterator.Close()
+
+	for _, audience := range audiences {
+		audHash := sha256.Sum256([]byte(audience.Aud))
+		hashStore.Set(types.AudienceHashKey(audHash[:]), []byte(audience.Aud))
+
+		claimKey := types.AudienceClaimKey(audHash[:])
+
+		// a deleted claim is recreated without a deposit, it was refunded.
+		// A claim left with a former admin keeps its deposit, refunded to
+		// the admin like the claims UpdateAudience moves.
+		var claim types.AudienceClaim
+		if bz := claimStore.Get(claimKey); bz != nil {
+			cdc.MustUnmarshal(bz, &claim)
+		}
+		if claim.Expiration != nil {
+			expiryStore.Delete(types.AudienceClaimExpiryKey(*claim.Expiration, audHash[:]))
+			claim.Expiration = nil
+		}
+		claim.Signer = audience.Admin
+
+		claimStore.Set(claimKey, cdc.MustMarshal(&claim))
+	}
+
+	return nil
+}
@@ -5,16 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,9 +30,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Audience struct {
-	Aud   string `protobuf:"bytes,1,opt,name=aud,proto3" json:"aud,omitempty"`
+	Aud string `protobuf:"bytes,1,opt,name=aud,proto3" json:"aud,omitempty"`
+	// key is a single JWK or a JWK Set, tokens select the key by their kid
 	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// key_windows restrict when the keys with their kid are valid
+	KeyWindows []KeyWindow `protobuf:"bytes,4,rep,name=key_windows,json=keyWindows,proto3" json:"key_windows"`
+	// issuer, when set, is the required iss claim of the tokens
+	Issuer string `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// authorized_party, when set, is the required azp claim of the tokens
+	AuthorizedParty string `protobuf:"bytes,6,opt,name=authorized_party,json=authorizedParty,proto3" json:"authorized_party,omitempty"`
 }
 
 func (m *Audience) Reset()         { *m = Audience{} }
@@ -82,15 +96,103 @@ func (m *Audience) GetAdmin() string {
 	return ""
 }
 
+func (m *Audience) GetKeyWindows() []KeyWindow {
+	if m != nil {
+		return m.KeyWindows
+	}
+	return nil
+}
+
+func (m *Audience) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Audience) GetAuthorizedParty() string {
+	if m != nil {
+		return m.AuthorizedParty
+	}
+	return ""
+}
+
+// KeyWindow is the validity window of an audience key, against the block
+// time adjusted by the time offset
+type KeyWindow struct {
+	Kid       string     `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	NotBefore *time.Time `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
+	NotAfter  *time.Time `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after,omitempty"`
+}
+
+func (m *KeyWindow) Reset()         { *m = KeyWindow{} }
+func (m *KeyWindow) String() string { return proto.CompactTextString(m) }
+func (*KeyWindow) ProtoMessage()    {}
+func (*KeyWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7862d6c296912c34, []int{1}
+}
+func (m *KeyWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyWindow.Merge(m, src)
+}
+func (m *KeyWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyWindow proto.InternalMessageInfo
+
+func (m *KeyWindow) GetKid() string {
+	if m != nil {
+		return m.Kid
+	}
+	return ""
+}
+
+func (m *KeyWindow) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *KeyWindow) GetNotAfter() *time.Time {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
 type AudienceClaim struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// deposit is refunded to the signer when the claim is deleted
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// expiration is when the claim expires if no audience uses it, unset while
+	// an audience uses it
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *AudienceClaim) Reset()         { *m = AudienceClaim{} }
 func (m *AudienceClaim) String() string { return proto.CompactTextString(m) }
 func (*AudienceClaim) ProtoMessage()    {}
 func (*AudienceClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7862d6c296912c34, []int{1}
+	return fileDescriptor_7862d6c296912c34, []int{2}
 }
 func (m *AudienceClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,28 +228,61 @@ func (m *AudienceClaim) GetSigner() string {
 	return ""
 }
 
+func (m *AudienceClaim) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *AudienceClaim) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Audience)(nil), "xion.jwk.v1.Audience")
+	proto.RegisterType((*KeyWindow)(nil), "xion.jwk.v1.KeyWindow")
 	proto.RegisterType((*AudienceClaim)(nil), "xion.jwk.v1.AudienceClaim")
 }
 
 func init() { proto.RegisterFile("xion/jwk/v1/audience.proto", fileDescriptor_7862d6c296912c34) }
 
 var fileDescriptor_7862d6c296912c34 = []byte{
-	// 204 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xaa, 0xc8, 0xcc, 0xcf,
-	0xd3, 0xcf, 0x2a, 0xcf, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x2c, 0x4d, 0xc9, 0x4c, 0xcd, 0x4b, 0x4e,
-	0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x06, 0xc9, 0xe9, 0x65, 0x95, 0x67, 0xeb, 0x95,
-	0x19, 0x2a, 0xb9, 0x70, 0x71, 0x38, 0x42, 0xa5, 0x85, 0x04, 0xb8, 0x98, 0x13, 0x4b, 0x53, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x40, 0x4c, 0x90, 0x48, 0x76, 0x6a, 0xa5, 0x04, 0x13, 0x44,
-	0x24, 0x3b, 0xb5, 0x52, 0x48, 0x84, 0x8b, 0x35, 0x31, 0x25, 0x37, 0x33, 0x4f, 0x82, 0x19, 0x2c,
-	0x06, 0xe1, 0x28, 0xa9, 0x73, 0xf1, 0xc2, 0x4c, 0x71, 0xce, 0x49, 0xcc, 0xcc, 0x15, 0x12, 0xe3,
-	0x62, 0x2b, 0xce, 0x4c, 0xcf, 0x4b, 0x2d, 0x82, 0x9a, 0x06, 0xe5, 0x39, 0x39, 0x9c, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x52, 0x69, 0x51, 0x5e, 0x89, 0x6e, 0x4e, 0x62, 0x52, 0xb1, 0x3e, 0xd8,
-	0x1f, 0x15, 0x60, 0x9f, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d, 0x61, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x54, 0x38, 0xbe, 0x1c, 0xe2, 0x00, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0xb5, 0x62, 0xc7, 0x8d, 0x69, 0x14, 0x0d, 0x84, 0x20, 0x50, 0x3d, 0xc8, 0x81, 0x87, 0xc2,
+	0x1d, 0x42, 0xd6, 0xe9, 0x1c, 0x34, 0x71, 0xc6, 0x2e, 0x85, 0x51, 0xa0, 0x40, 0x17, 0x83, 0xb2,
+	0xce, 0x0a, 0x23, 0x8b, 0x27, 0x88, 0x94, 0x6d, 0xf5, 0x2b, 0xf2, 0x03, 0xfd, 0x81, 0x7e, 0x48,
+	0x91, 0xd1, 0x63, 0xa7, 0xa6, 0xb0, 0x7f, 0xa4, 0x20, 0x25, 0x25, 0x1e, 0x33, 0x91, 0xf7, 0xee,
+	0x1e, 0xef, 0xde, 0x3b, 0x92, 0xde, 0x5a, 0xa0, 0x64, 0x77, 0xab, 0x98, 0x2d, 0x47, 0x8c, 0xe7,
+	0xa1, 0x00, 0x39, 0x03, 0x9a, 0x66, 0xa8, 0xd1, 0xed, 0x9a, 0x1c, 0xbd, 0x5b, 0xc5, 0x74, 0x39,
+	0xea, 0x9d, 0x44, 0x18, 0xa1, 0xc5, 0x99, 0xb9, 0x95, 0x25, 0xbd, 0x7e, 0x84, 0x18, 0x2d, 0x80,
+	0xd9, 0x28, 0xc8, 0xe7, 0x4c, 0x8b, 0x04, 0x94, 0xe6, 0x49, 0x5a, 0x15, 0xf8, 0x33, 0x54, 0x09,
+	0x2a, 0x16, 0x70, 0x05, 0x6c, 0x39, 0x0a, 0x40, 0xf3, 0x11, 0x9b, 0xa1, 0x90, 0x65, 0x7e, 0xf0,
+	0xdb, 0x21, 0x47, 0xd7, 0x55, 0x5b, 0xf7, 0x98, 0x34, 0x79, 0x1e, 0x7a, 0xce, 0x99, 0x33, 0xec,
+	0x4c, 0xcc, 0xd5, 0x20, 0x31, 0x14, 0xde, 0x41, 0x89, 0xc4, 0x50, 0xb8, 0x27, 0xe4, 0x90, 0x87,
+	0x89, 0x90, 0x5e, 0xd3, 0x62, 0x65, 0xe0, 0x5e, 0x92, 0x6e, 0x0c, 0xc5, 0x74, 0x25, 0x64, 0x88,
+	0x2b, 0xe5, 0xb5, 0xce, 0x9a, 0xc3, 0xee, 0xc5, 0x29, 0xdd, 0x13, 0x40, 0x3f, 0x43, 0xf1, 0xcd,
+	0xa6, 0xc7, 0xad, 0x87, 0xbf, 0xfd, 0xc6, 0x84, 0xc4, 0x35, 0xa0, 0xdc, 0x53, 0xd2, 0x16, 0x4a,
+	0xe5, 0x90, 0x79, 0x87, 0xf6, 0xd5, 0x2a, 0x72, 0xdf, 0x93, 0x63, 0x9e, 0xeb, 0x5b, 0xcc, 0xc4,
+	0x0f, 0x08, 0xa7, 0x29, 0xcf, 0x74, 0xe1, 0xb5, 0x6d, 0xc5, 0x9b, 0x67, 0xfc, 0x8b, 0x81, 0x07,
+	0x3f, 0x1d, 0xd2, 0x79, 0x6a, 0x61, 0xe7, 0x16, 0x4f, 0x4a, 0x62, 0x11, 0xba, 0x9f, 0x08, 0x91,
+	0xa8, 0xa7, 0x01, 0xcc, 0x31, 0x03, 0x2b, 0xa8, 0x7b, 0xd1, 0xa3, 0xa5, 0x7d, 0xb4, 0xb6, 0x8f,
+	0x7e, 0xad, 0xed, 0x1b, 0xb7, 0xee, 0x1f, 0xfb, 0xce, 0xa4, 0x23, 0x51, 0x8f, 0x2d, 0xc5, 0xbd,
+	0x24, 0x26, 0x98, 0xf2, 0xb9, 0x86, 0xcc, 0x6b, 0xbe, 0x90, 0x7f, 0x24, 0x51, 0x5f, 0x1b, 0xc6,
+	0x60, 0xe3, 0x90, 0xd7, 0xb5, 0xd1, 0x37, 0x0b, 0x2e, 0x12, 0x23, 0x5a, 0x89, 0x48, 0x42, 0x56,
+	0x8d, 0x59, 0x45, 0x2e, 0x90, 0x57, 0x21, 0xa4, 0xa8, 0x84, 0xf6, 0x0e, 0xac, 0x8f, 0x6f, 0x69,
+	0xb9, 0x44, 0x6a, 0x96, 0x48, 0xab, 0x25, 0xd2, 0x1b, 0x14, 0x72, 0xfc, 0xc1, 0x58, 0xf9, 0xeb,
+	0xb1, 0x3f, 0x8c, 0x84, 0xbe, 0xcd, 0x03, 0x3a, 0xc3, 0x84, 0x55, 0x1b, 0x2f, 0x8f, 0x73, 0x15,
+	0xc6, 0x4c, 0x17, 0x29, 0x28, 0x4b, 0x50, 0x93, 0xfa, 0x6d, 0xf7, 0x8a, 0x10, 0x58, 0xa7, 0x22,
+	0xe3, 0x5a, 0xa0, 0x7c, 0xb1, 0xa0, 0x3d, 0xce, 0xf8, 0xea, 0x61, 0xeb, 0x3b, 0x9b, 0xad, 0xef,
+	0xfc, 0xdb, 0xfa, 0xce, 0xfd, 0xce, 0x6f, 0x6c, 0x76, 0x7e, 0xe3, 0xcf, 0xce, 0x6f, 0x7c, 0x7f,
+	0xb7, 0x37, 0x4e, 0x90, 0x67, 0x52, 0x9f, 0x2f, 0x78, 0xa0, 0x98, 0xfd, 0xeb, 0x6b, 0xfb, 0xdb,
+	0xed, 0x48, 0x41, 0xdb, 0xf6, 0xf9, 0xf8, 0x7f, 0x00, 0xae, 0x6a, 0x7b, 0xb2, 0x06, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Audience) Marshal() (dAtA []byte, err error) {
@@ -170,6 +305,34 @@ func (m *Audience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuthorizedParty) > 0 {
+		i -= len(m.AuthorizedParty)
+		copy(dAtA[i:], m.AuthorizedParty)
+		i = encodeVarintAudience(dAtA, i, uint64(len(m.AuthorizedParty)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAudience(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.KeyWindows) > 0 {
+		for iNdEx := len(m.KeyWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAudience(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -194,6 +357,56 @@ func (m *Audience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NotAfter != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAudience(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NotBefore != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAudience(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kid) > 0 {
+		i -= len(m.Kid)
+		copy(dAtA[i:], m.Kid)
+		i = encodeVarintAudience(dAtA, i, uint64(len(m.Kid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AudienceClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -214,6 +427,30 @@ func (m *AudienceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAudience(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAudience(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -253,6 +490,41 @@ func (m *Audience) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAudience(uint64(l))
 	}
+	if len(m.KeyWindows) > 0 {
+		for _, e := range m.KeyWindows {
+			l = e.Size()
+			n += 1 + l + sovAudience(uint64(l))
+		}
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAudience(uint64(l))
+	}
+	l = len(m.AuthorizedParty)
+	if l > 0 {
+		n += 1 + l + sovAudience(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kid)
+	if l > 0 {
+		n += 1 + l + sovAudience(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovAudience(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter)
+		n += 1 + l + sovAudience(uint64(l))
+	}
 	return n
 }
 
@@ -266,6 +538,16 @@ func (m *AudienceClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAudience(uint64(l))
 	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovAudience(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAudience(uint64(l))
+	}
 	return n
 }
 
@@ -400,6 +682,258 @@ func (m *Audience) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyWindows = append(m.KeyWindows, KeyWindow{})
+			if err := m.KeyWindows[len(m.KeyWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedParty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedParty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudience(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudience
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotAfter == nil {
+				m.NotAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAudience(dAtA[iNdEx:])
@@ -482,6 +1016,76 @@ func (m *AudienceClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudience
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAudience
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudience
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAudience(dAtA[iNdEx:])
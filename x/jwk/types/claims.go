@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ClaimAuthorizedParty is the claim of the party the token was issued to
+const ClaimAuthorizedParty = "azp"
+
+// JSON types of private claims
+const (
+	ClaimTypeString  = "string"
+	ClaimTypeNumber  = "number"
+	ClaimTypeBoolean = "boolean"
+	ClaimTypeArray   = "array"
+	ClaimTypeObject  = "object"
+	ClaimTypeNull    = "null"
+)
+
+// NewPrivateClaims returns the named claims of a JWT payload sorted by key.
+// Claims are decoded from the payload again so numbers keep the precision
+// they have in the token.
+func NewPrivateClaims(payload []byte, keys []string) ([]*PrivateClaim, error) {
+	var rawClaims map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &rawClaims); err != nil {
+		return nil, err
+	}
+
+	privateClaims := make([]*PrivateClaim, 0, len(keys))
+	for _, key := range keys {
+		raw, ok := rawClaims[key]
+		if !ok {
+			return nil, fmt.Errorf("claim %s not found in payload", key)
+		}
+
+		claim, err := NewPrivateClaim(key, raw)
+		if err != nil {
+			return nil, err
+		}
+		privateClaims = append(privateClaims, claim)
+	}
+
+	// even though there should be no duplicates, sort this deterministically
+	sort.SliceStable(privateClaims, func(i, j int) bool {
+		return privateClaims[i].Key < privateClaims[j].Key
+	})
+
+	return privateClaims, nil
+}
+
+// NewPrivateClaim returns a claim from its raw JSON value. String claims keep
+// their value, other claims use their canonical JSON as value.
+func NewPrivateClaim(key string, raw json.RawMessage) (*PrivateClaim, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("claim %s: %w", key, err)
+	}
+
+	jsonValue, err := canonicalJSON(value)
+	if err != nil {
+		return nil, fmt.Errorf("claim %s: %w", key, err)
+	}
+
+	claim := &PrivateClaim{
+		Key:       key,
+		Value:     jsonValue,
+		JsonValue: jsonValue,
+	}
+
+	switch v := value.(type) {
+	case string:
+		claim.Type = ClaimTypeString
+		claim.Value = v
+	case json.Number:
+		claim.Type = ClaimTypeNumber
+	case bool:
+		claim.Type = ClaimTypeBoolean
+	case []interface{}:
+		claim.Type = ClaimTypeArray
+	case map[string]interface{}:
+		claim.Type = ClaimTypeObject
+	case nil:
+		claim.Type = ClaimTypeNull
+	default:
+		return nil, fmt.Errorf("claim %s: unexpected type %T", key, value)
+	}
+
+	return claim, nil
+}
+
+// canonicalJSON encodes a decoded JSON value without insignificant
+// whitespace, with object keys sorted and without HTML escaping
+func canonicalJSON(value interface{}) (string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n"))), nil
+}
@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/burnt-labs/xion/x/jwk/types"
+)
+
+func TestNewPrivateClaims(t *testing.T) {
+	payload := []byte(`{
+		"sub": "user-test",
+		"email": "user@burnt.com",
+		"email_verified": true,
+		"balance": 12345678901234567890,
+		"ratio": 1.50,
+		"roles": ["admin", 1],
+		"profile": {"z": 1, "a": "<b>"},
+		"nickname": null
+	}`)
+
+	claims, err := types.NewPrivateClaims(payload, []string{"roles", "email_verified", "email", "balance", "profile", "nickname", "ratio"})
+	require.NoError(t, err)
+
+	expected := []*types.PrivateClaim{
+		{Key: "balance", Value: "12345678901234567890", Type: types.ClaimTypeNumber, JsonValue: "12345678901234567890"},
+		{Key: "email", Value: "user@burnt.com", Type: types.ClaimTypeString, JsonValue: `"user@burnt.com"`},
+		{Key: "email_verified", Value: "true", Type: types.ClaimTypeBoolean, JsonValue: "true"},
+		{Key: "nickname", Value: "null", Type: types.ClaimTypeNull, JsonValue: "null"},
+		{Key: "profile", Value: `{"a":"<b>","z":1}`, Type: types.ClaimTypeObject, JsonValue: `{"a":"<b>","z":1}`},
+		{Key: "ratio", Value: "1.50", Type: types.ClaimTypeNumber, JsonValue: "1.50"},
+		{Key: "roles", Value: `["admin",1]`, Type: types.ClaimTypeArray, JsonValue: `["admin",1]`},
+	}
+	require.Equal(t, expected, claims)
+
+	_, err = types.NewPrivateClaims(payload, []string{"missing"})
+	require.Error(t, err)
+}
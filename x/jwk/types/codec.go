@@ -2,23 +2,44 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/codec"
+	groupcodec "github.com/cosmos/cosmos-sdk/x/group/codec"
 )
 
-func RegisterCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(&MsgCreateAudience{}, "jwk/CreateAudience", nil)
-	cdc.RegisterConcrete(&MsgUpdateAudience{}, "jwk/UpdateAudience", nil)
-	cdc.RegisterConcrete(&MsgDeleteAudience{}, "jwk/DeleteAudience", nil)
+// RegisterLegacyAminoCodec registers the x/jwk concrete types on the provided
+// LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateAudienceClaim{}, "jwk/MsgCreateAudienceClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteAudienceClaim{}, "jwk/MsgDeleteAudienceClaim")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateAudience{}, "jwk/MsgCreateAudience")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateAudience{}, "jwk/MsgUpdateAudience")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteAudience{}, "jwk/MsgDeleteAudience")
+	legacy.RegisterAminoMsg(cdc, &MsgAddAudienceKey{}, "jwk/MsgAddAudienceKey")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveAudienceKey{}, "jwk/MsgRemoveAudienceKey")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAudienceKeyWindow{}, "jwk/MsgSetAudienceKeyWindow")
+	legacy.RegisterAminoMsg(cdc, &MsgConsumeJWT{}, "jwk/MsgConsumeJWT")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "jwk/MsgUpdateParams")
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateAudienceClaim{},
+		&MsgDeleteAudienceClaim{},
 		&MsgCreateAudience{},
 		&MsgUpdateAudience{},
 		&MsgDeleteAudience{},
+		&MsgAddAudienceKey{},
+		&MsgRemoveAudienceKey{},
+		&MsgSetAudienceKeyWindow{},
+		&MsgConsumeJWT{},
+		&MsgUpdateParams{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -26,6 +47,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 }
 
 var (
-	Amino     = codec.NewLegacyAmino()
-	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
 )
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz and gov Amino codec so that this can later be
+	// used to properly serialize MsgGrant, MsgExec and MsgSubmitProposal instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+	RegisterLegacyAminoCodec(govcodec.Amino)
+	RegisterLegacyAminoCodec(groupcodec.Amino)
+}
@@ -8,5 +8,10 @@ import (
 
 // x/jwk module sentinel errors
 var (
-	ErrInvalidJWK = errorsmod.Register(ModuleName, 1100, "invalid jwk")
+	ErrInvalidJWK       = errorsmod.Register(ModuleName, 1100, "invalid jwk")
+	ErrUnknownKeyID     = errorsmod.Register(ModuleName, 1101, "unknown key id")
+	ErrInvalidKeyWindow = errorsmod.Register(ModuleName, 1102, "invalid key window")
+	ErrKeyNotActive     = errorsmod.Register(ModuleName, 1103, "key not active")
+	ErrJWTConsumed      = errorsmod.Register(ModuleName, 1104, "jwt already consumed")
+	ErrJWTNoExpiration  = errorsmod.Register(ModuleName, 1105, "jwt has no expiration")
 )
@@ -0,0 +1,10 @@
+package types
+
+// jwk module event types
+const (
+	EventTypeAudienceClaimExpiryFailed = "audience_claim_expiry_failed"
+
+	AttributeKeyAudHash    = "aud_hash"
+	AttributeKeyExpiration = "expiration"
+	AttributeKeyError      = "error"
+)
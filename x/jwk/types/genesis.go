@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,7 +16,9 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		AudienceList: []Audience{},
+		AudienceList:      []Audience{},
+		AudienceClaimList: []GenesisAudienceClaim{},
+		ConsumedJWTList:   []ConsumedJWT{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -24,6 +27,27 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated claims, indexed by the audience hash
+	claims := make(map[string]GenesisAudienceClaim)
+	for _, elem := range gs.AudienceClaimList {
+		if len(elem.AudHash) != 32 {
+			return fmt.Errorf("audience claim hash must be 32 byte sha256")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Signer); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid audience claim signer (%s)", err)
+		}
+
+		if err := elem.Deposit.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid audience claim deposit (%s)", err)
+		}
+
+		if _, ok := claims[string(elem.AudHash)]; ok {
+			return fmt.Errorf("duplicated audience claim")
+		}
+		claims[string(elem.AudHash)] = elem
+	}
+
 	// Check for duplicated index in audience
 	audienceIndexMap := make(map[string]struct{})
 
@@ -38,6 +62,42 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for audience")
 		}
 		audienceIndexMap[index] = struct{}{}
+
+		// an audience can only exist under a claim of its admin
+		audHash := sha256.Sum256([]byte(elem.Aud))
+		claim, ok := claims[string(audHash[:])]
+		if !ok {
+			return fmt.Errorf("no claim for audience %s", elem.Aud)
+		}
+		if claim.Signer != elem.Admin {
+			return fmt.Errorf("claim for audience %s is owned by %s, not its admin %s", elem.Aud, claim.Signer, elem.Admin)
+		}
+		if claim.Expiration != nil {
+			return fmt.Errorf("claim for audience %s expires while in use", elem.Aud)
+		}
+
+		if err := elem.ValidateKeyWindows(); err != nil {
+			return errorsmod.Wrapf(err, "audience %s", elem.Aud)
+		}
+	}
+
+	// Check for duplicated consumed tokens
+	consumedIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ConsumedJWTList {
+		signer, err := sdk.AccAddressFromBech32(elem.Signer)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid consumed jwt signer (%s)", err)
+		}
+
+		if elem.TokenId == "" {
+			return fmt.Errorf("consumed jwt without token id")
+		}
+
+		index := string(ConsumedJWTKey(signer, elem.Aud, elem.TokenId))
+		if _, ok := consumedIndexMap[index]; ok {
+			return fmt.Errorf("duplicated consumed jwt %s", elem.TokenId)
+		}
+		consumedIndexMap[index] = struct{}{}
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,8 +31,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the jwk module's genesis state.
 type GenesisState struct {
-	Params       Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	AudienceList []Audience `protobuf:"bytes,2,rep,name=audienceList,proto3" json:"audienceList"`
+	Params            Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	AudienceList      []Audience             `protobuf:"bytes,2,rep,name=audienceList,proto3" json:"audienceList"`
+	AudienceClaimList []GenesisAudienceClaim `protobuf:"bytes,3,rep,name=audienceClaimList,proto3" json:"audienceClaimList"`
+	ConsumedJWTList   []ConsumedJWT          `protobuf:"bytes,4,rep,name=consumedJWTList,proto3" json:"consumedJWTList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,29 +84,128 @@ func (m *GenesisState) GetAudienceList() []Audience {
 	return nil
 }
 
+func (m *GenesisState) GetAudienceClaimList() []GenesisAudienceClaim {
+	if m != nil {
+		return m.AudienceClaimList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumedJWTList() []ConsumedJWT {
+	if m != nil {
+		return m.ConsumedJWTList
+	}
+	return nil
+}
+
+// GenesisAudienceClaim is an audience claim with the hash of its audience
+type GenesisAudienceClaim struct {
+	AudHash    []byte                                   `protobuf:"bytes,1,opt,name=aud_hash,json=audHash,proto3" json:"aud_hash,omitempty"`
+	Signer     string                                   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Deposit    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	Expiration *time.Time                               `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *GenesisAudienceClaim) Reset()         { *m = GenesisAudienceClaim{} }
+func (m *GenesisAudienceClaim) String() string { return proto.CompactTextString(m) }
+func (*GenesisAudienceClaim) ProtoMessage()    {}
+func (*GenesisAudienceClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_312c1a9c7511b5ef, []int{1}
+}
+func (m *GenesisAudienceClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisAudienceClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisAudienceClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisAudienceClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisAudienceClaim.Merge(m, src)
+}
+func (m *GenesisAudienceClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisAudienceClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisAudienceClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisAudienceClaim proto.InternalMessageInfo
+
+func (m *GenesisAudienceClaim) GetAudHash() []byte {
+	if m != nil {
+		return m.AudHash
+	}
+	return nil
+}
+
+func (m *GenesisAudienceClaim) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *GenesisAudienceClaim) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *GenesisAudienceClaim) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "xion.jwk.v1.GenesisState")
+	proto.RegisterType((*GenesisAudienceClaim)(nil), "xion.jwk.v1.GenesisAudienceClaim")
 }
 
 func init() { proto.RegisterFile("xion/jwk/v1/genesis.proto", fileDescriptor_312c1a9c7511b5ef) }
 
 var fileDescriptor_312c1a9c7511b5ef = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xac, 0xc8, 0xcc, 0xcf,
-	0xd3, 0xcf, 0x2a, 0xcf, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x06, 0x49, 0xe9, 0x65, 0x95, 0x67, 0xeb, 0x95, 0x19,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0x29, 0x09, 0x64,
-	0xdd, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0xcd, 0x52, 0x52, 0xc8, 0x32, 0x89, 0xa5, 0x29, 0x99,
-	0xa9, 0x79, 0xc9, 0xa9, 0x10, 0x39, 0xa5, 0x26, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x55, 0xc1, 0x25,
-	0x89, 0x25, 0xa9, 0x42, 0x86, 0x5c, 0x6c, 0x10, 0xcd, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46,
-	0xc2, 0x7a, 0x48, 0x56, 0xeb, 0x05, 0x80, 0xa5, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82,
-	0x2a, 0x14, 0xb2, 0xe7, 0xe2, 0x81, 0x99, 0xea, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xa4, 0xc0, 0xac,
-	0xc1, 0x6d, 0x24, 0x8a, 0xa2, 0xd1, 0x11, 0xaa, 0x00, 0xaa, 0x15, 0x45, 0x83, 0x93, 0xc3, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0x95, 0x16, 0xe5, 0x95, 0xe8, 0xe6, 0x24, 0x26, 0x15, 0xeb,
-	0x83, 0x3d, 0x54, 0x01, 0xf6, 0x52, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x37, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x54, 0x7a, 0x47, 0x43, 0x01, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0xaf, 0xbb, 0xaa, 0x03, 0xb7, 0x12, 0xc2, 0x6c, 0x28, 0xcd, 0x21, 0x2d, 0x3b, 0xa0, 0x5e,
+	0x66, 0x93, 0xf1, 0x00, 0x6c, 0xed, 0x81, 0x09, 0x71, 0x40, 0x61, 0x08, 0x89, 0x0b, 0x72, 0x12,
+	0x93, 0xba, 0x6d, 0xec, 0x28, 0x76, 0xda, 0xf2, 0x16, 0x7b, 0x07, 0x6e, 0x3c, 0xc9, 0x8e, 0x3b,
+	0x72, 0x62, 0xa8, 0x7d, 0x03, 0x9e, 0x00, 0xd5, 0x71, 0x44, 0x02, 0x3b, 0xb5, 0x9f, 0x7f, 0x7f,
+	0xbe, 0xef, 0xcb, 0xf7, 0x83, 0x83, 0x0d, 0x97, 0x82, 0xcc, 0xd7, 0x0b, 0xb2, 0xf2, 0x49, 0xc2,
+	0x04, 0x53, 0x5c, 0xe1, 0x2c, 0x97, 0x5a, 0xa2, 0xde, 0x1e, 0xc2, 0xf3, 0xf5, 0x02, 0xaf, 0x7c,
+	0xf7, 0x28, 0x91, 0x89, 0x34, 0xef, 0x64, 0xff, 0xaf, 0xa4, 0xb8, 0xc3, 0x44, 0xca, 0x64, 0xc9,
+	0x88, 0xa9, 0xc2, 0xe2, 0x0b, 0xd1, 0x3c, 0x65, 0x4a, 0xd3, 0x34, 0xb3, 0x04, 0x2f, 0x92, 0x2a,
+	0x95, 0x8a, 0x84, 0x54, 0x31, 0xb2, 0xf2, 0x43, 0xa6, 0xa9, 0x4f, 0x22, 0xc9, 0x85, 0xc5, 0x9d,
+	0x7a, 0xfb, 0x8c, 0xe6, 0x34, 0xb5, 0xdd, 0x5d, 0xb7, 0x8e, 0xd0, 0x22, 0xe6, 0x4c, 0x44, 0xcc,
+	0x62, 0xc7, 0x75, 0x6c, 0xbe, 0xd6, 0xe5, 0xf3, 0xc9, 0xb7, 0x36, 0xec, 0xbf, 0x2e, 0x57, 0x78,
+	0xaf, 0xa9, 0x66, 0xc8, 0x87, 0xdd, 0xd2, 0xd3, 0x01, 0x23, 0x30, 0xee, 0x9d, 0x3d, 0xc1, 0xb5,
+	0x95, 0xf0, 0x3b, 0x03, 0x4d, 0x3a, 0x37, 0x3f, 0x87, 0xad, 0xc0, 0x12, 0xd1, 0x2b, 0xd8, 0xaf,
+	0x9a, 0xbd, 0xe5, 0x4a, 0x3b, 0xed, 0xd1, 0xc1, 0xb8, 0x77, 0x76, 0xdc, 0x10, 0x5e, 0x58, 0x82,
+	0x95, 0x36, 0x04, 0xe8, 0x03, 0x7c, 0x5c, 0xd5, 0xd3, 0x25, 0xe5, 0xa9, 0x71, 0x39, 0x30, 0x2e,
+	0xcf, 0x1a, 0x2e, 0x76, 0xd2, 0x8b, 0x3a, 0xd9, 0x3a, 0xfe, 0xef, 0x80, 0x2e, 0xe1, 0xa3, 0x48,
+	0x0a, 0x55, 0xa4, 0x2c, 0x7e, 0xf3, 0xf1, 0xca, 0x98, 0x76, 0x8c, 0xa9, 0xd3, 0x30, 0x9d, 0xfe,
+	0xe5, 0x58, 0xaf, 0x7f, 0x65, 0x27, 0xbf, 0x01, 0x3c, 0xba, 0xaf, 0x37, 0x1a, 0xc0, 0x07, 0xb4,
+	0x88, 0x3f, 0xcf, 0xa8, 0x9a, 0x99, 0xef, 0xd5, 0x0f, 0x0e, 0x69, 0x11, 0x5f, 0x52, 0x35, 0x43,
+	0x4f, 0x61, 0x57, 0xf1, 0x44, 0xb0, 0xdc, 0x69, 0x8f, 0xc0, 0xf8, 0x61, 0x60, 0x2b, 0xc4, 0xe0,
+	0x61, 0xcc, 0x32, 0xa9, 0x78, 0xb5, 0xe2, 0x00, 0x97, 0x07, 0xc7, 0xfb, 0x83, 0x63, 0x7b, 0x70,
+	0x3c, 0x95, 0x5c, 0x4c, 0x5e, 0xec, 0xc7, 0xf9, 0x7e, 0x37, 0x1c, 0x27, 0x5c, 0xcf, 0x8a, 0x10,
+	0x47, 0x32, 0x25, 0x36, 0x1d, 0xe5, 0xcf, 0xa9, 0x8a, 0x17, 0x44, 0x7f, 0xcd, 0x98, 0x32, 0x02,
+	0x15, 0x54, 0xde, 0xe8, 0x1c, 0x42, 0xb6, 0xc9, 0x78, 0x4e, 0x35, 0x97, 0xc2, 0xe9, 0x98, 0x5b,
+	0xba, 0xb8, 0xcc, 0x1e, 0xae, 0xb2, 0x87, 0xaf, 0xaa, 0xec, 0x4d, 0x3a, 0xd7, 0x77, 0x43, 0x10,
+	0xd4, 0x34, 0x93, 0xf3, 0x9b, 0xad, 0x07, 0x6e, 0xb7, 0x1e, 0xf8, 0xb5, 0xf5, 0xc0, 0xf5, 0xce,
+	0x6b, 0xdd, 0xee, 0xbc, 0xd6, 0x8f, 0x9d, 0xd7, 0xfa, 0xf4, 0xbc, 0x36, 0x4e, 0x58, 0xe4, 0x42,
+	0x9f, 0x2e, 0x69, 0xa8, 0x88, 0x49, 0xd8, 0xc6, 0x64, 0xcc, 0x8c, 0x14, 0x76, 0x4d, 0x9f, 0x97,
+	0x7f, 0x06, 0x00, 0x7a, 0x5d, 0x66, 0x3b, 0x31, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +228,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumedJWTList) > 0 {
+		for iNdEx := len(m.ConsumedJWTList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumedJWTList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AudienceClaimList) > 0 {
+		for iNdEx := len(m.AudienceClaimList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AudienceClaimList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AudienceList) > 0 {
 		for iNdEx := len(m.AudienceList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -148,6 +283,67 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisAudienceClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisAudienceClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisAudienceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGenesis(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AudHash) > 0 {
+		i -= len(m.AudHash)
+		copy(dAtA[i:], m.AudHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AudHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -173,6 +369,45 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AudienceClaimList) > 0 {
+		for _, e := range m.AudienceClaimList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumedJWTList) > 0 {
+		for _, e := range m.ConsumedJWTList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisAudienceClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AudHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -278,6 +513,260 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudienceClaimList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AudienceClaimList = append(m.AudienceClaimList, GenesisAudienceClaim{})
+			if err := m.AudienceClaimList[len(m.AudienceClaimList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumedJWTList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumedJWTList = append(m.ConsumedJWTList, ConsumedJWT{})
+			if err := m.ConsumedJWTList[len(m.ConsumedJWTList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisAudienceClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisAudienceClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisAudienceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AudHash = append(m.AudHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AudHash == nil {
+				m.AudHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -35,6 +37,34 @@ func TestGenesisState_Validate(t *testing.T) {
 						Admin: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 					},
 				},
+				AudienceClaimList: []types.GenesisAudienceClaim{
+					{
+						AudHash: audHash("0"),
+						Signer:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+					{
+						AudHash: audHash("1"),
+						Signer:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+					{
+						AudHash: audHash("unused"),
+						Signer:  authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+					},
+				},
+				ConsumedJWTList: []types.ConsumedJWT{
+					{
+						Signer:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+						Aud:        "0",
+						TokenId:    "token-1",
+						Expiration: time.Unix(1700000000, 0).UTC(),
+					},
+					{
+						Signer:     authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+						Aud:        "0",
+						TokenId:    "token-1",
+						Expiration: time.Unix(1700000000, 0).UTC(),
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -52,6 +82,103 @@ func TestGenesisState_Validate(t *testing.T) {
 						Admin: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 					},
 				},
+				AudienceClaimList: []types.GenesisAudienceClaim{
+					{
+						AudHash: audHash("0"),
+						Signer:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "audience without claim",
+			genState: &types.GenesisState{
+				AudienceList: []types.Audience{
+					{
+						Aud:   "0",
+						Admin: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "audience claimed by another account",
+			genState: &types.GenesisState{
+				AudienceList: []types.Audience{
+					{
+						Aud:   "0",
+						Admin: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+				},
+				AudienceClaimList: []types.GenesisAudienceClaim{
+					{
+						AudHash: audHash("0"),
+						Signer:  authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated audience claim",
+			genState: &types.GenesisState{
+				AudienceClaimList: []types.GenesisAudienceClaim{
+					{
+						AudHash: audHash("0"),
+						Signer:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+					{
+						AudHash: audHash("0"),
+						Signer:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid audience claim hash",
+			genState: &types.GenesisState{
+				AudienceClaimList: []types.GenesisAudienceClaim{
+					{
+						AudHash: []byte("0"),
+						Signer:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated consumed jwt",
+			genState: &types.GenesisState{
+				ConsumedJWTList: []types.ConsumedJWT{
+					{
+						Signer:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+						Aud:        "0",
+						TokenId:    "token-1",
+						Expiration: time.Unix(1700000000, 0).UTC(),
+					},
+					{
+						Signer:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+						Aud:        "0",
+						TokenId:    "token-1",
+						Expiration: time.Unix(1700000060, 0).UTC(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "consumed jwt without token id",
+			genState: &types.GenesisState{
+				ConsumedJWTList: []types.ConsumedJWT{
+					{
+						Signer:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+						Aud:        "0",
+						Expiration: time.Unix(1700000000, 0).UTC(),
+					},
+				},
 			},
 			valid: false,
 		},
@@ -68,3 +195,8 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func audHash(aud string) []byte {
+	hash := sha256.Sum256([]byte(aud))
+	return hash[:]
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ParseAudienceKeys parses the key of an audience, either a single JWK or a
+// JWK Set. Keys of a set with more than one key must have unique kids.
+func ParseAudienceKeys(key string) (jwk.Set, error) {
+	set, err := jwk.Parse([]byte(key))
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidJWK, "invalid jwk format (%s)", err)
+	}
+
+	if set.Len() == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidJWK, "empty jwk set")
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < set.Len(); i++ {
+		key, _ := set.Key(i)
+		if err := validateKeyAlgorithm(key); err != nil {
+			return nil, err
+		}
+
+		kid := key.KeyID()
+		if kid == "" && set.Len() > 1 {
+			return nil, errorsmod.Wrap(ErrInvalidJWK, "keys of a jwk set must have a kid")
+		}
+		if seen[kid] {
+			return nil, errorsmod.Wrapf(ErrInvalidJWK, "duplicate kid %s", kid)
+		}
+		seen[kid] = true
+	}
+
+	return set, nil
+}
+
+// ParseAudienceKey parses a single JWK to add to the key set of an audience
+func ParseAudienceKey(key string) (jwk.Key, error) {
+	parsed, err := jwk.ParseKey([]byte(key))
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidJWK, "invalid jwk format (%s)", err)
+	}
+
+	if parsed.KeyID() == "" {
+		return nil, errorsmod.Wrap(ErrInvalidJWK, "key must have a kid")
+	}
+
+	if err := validateKeyAlgorithm(parsed); err != nil {
+		return nil, err
+	}
+
+	return parsed, nil
+}
+
+func validateKeyAlgorithm(key jwk.Key) error {
+	var sigAlg jwa.SignatureAlgorithm
+	if err := sigAlg.Accept(key.Algorithm().String()); err != nil {
+		return errorsmod.Wrapf(ErrInvalidJWK, "invalid algorithm (%s)", err)
+	}
+
+	switch sigAlg {
+	case jwa.HS256, jwa.HS384, jwa.HS512, jwa.NoSignature:
+		return fmt.Errorf("invalid algorithm: %s", sigAlg.String())
+	}
+
+	return nil
+}
+
+// AddAudienceKey adds a key to the keys of an audience and returns the
+// resulting JWK Set
+func AddAudienceKey(keys, key string) (string, error) {
+	set, err := ParseAudienceKeys(keys)
+	if err != nil {
+		return "", err
+	}
+
+	newKey, err := ParseAudienceKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < set.Len(); i++ {
+		existing, _ := set.Key(i)
+		if existing.KeyID() == "" {
+			return "", errorsmod.Wrap(ErrInvalidJWK, "the audience key has no kid, update the audience first")
+		}
+	}
+
+	if _, found := set.LookupKeyID(newKey.KeyID()); found {
+		return "", errorsmod.Wrapf(ErrInvalidJWK, "duplicate kid %s", newKey.KeyID())
+	}
+
+	if err := set.AddKey(newKey); err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidJWK, "error adding key (%s)", err)
+	}
+
+	return marshalKeySet(set)
+}
+
+// RemoveAudienceKey removes the key with kid from the keys of an audience and
+// returns the resulting JWK Set. The last key of an audience cannot be removed.
+func RemoveAudienceKey(keys, kid string) (string, error) {
+	set, err := ParseAudienceKeys(keys)
+	if err != nil {
+		return "", err
+	}
+
+	key, found := set.LookupKeyID(kid)
+	if !found {
+		return "", errorsmod.Wrapf(ErrUnknownKeyID, "kid %s", kid)
+	}
+
+	if set.Len() == 1 {
+		return "", errorsmod.Wrap(ErrInvalidJWK, "cannot remove the last key of an audience")
+	}
+
+	if err := set.RemoveKey(key); err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidJWK, "error removing key (%s)", err)
+	}
+
+	return marshalKeySet(set)
+}
+
+func marshalKeySet(set jwk.Set) (string, error) {
+	bz, err := json.Marshal(set)
+	if err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidJWK, "error encoding jwk set (%s)", err)
+	}
+
+	return string(bz), nil
+}
+
+// SelectAudienceKey returns the key of the set matching the kid header of the
+// token. A token without kid can only be verified by an audience with a
+// single key.
+func SelectAudienceKey(set jwk.Set, token []byte) (jwk.Key, error) {
+	msg, err := jws.Parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := msg.Signatures()
+	if len(signatures) != 1 {
+		return nil, fmt.Errorf("expected a single signature, got %d", len(signatures))
+	}
+
+	// a single key without kid verifies any token, as before key sets
+	kid := signatures[0].ProtectedHeaders().KeyID()
+	if set.Len() == 1 {
+		if key, _ := set.Key(0); kid == "" || key.KeyID() == "" {
+			return key, nil
+		}
+	}
+	if kid == "" {
+		return nil, errorsmod.Wrap(ErrUnknownKeyID, "token has no kid and the audience has several keys")
+	}
+
+	key, found := set.LookupKeyID(kid)
+	if !found {
+		return nil, errorsmod.Wrapf(ErrUnknownKeyID, "kid %s", kid)
+	}
+
+	return key, nil
+}
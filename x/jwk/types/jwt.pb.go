@@ -0,0 +1,484 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: xion/jwk/v1/jwt.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConsumedJWT records a token consumed by a signer, so it cannot be used
+// again before it expires
+type ConsumedJWT struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Aud    string `protobuf:"bytes,2,opt,name=aud,proto3" json:"aud,omitempty"`
+	// token_id is the jti of the token, or the hex sha256 of its signing input
+	// when it has none
+	TokenId    string    `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ConsumedJWT) Reset()         { *m = ConsumedJWT{} }
+func (m *ConsumedJWT) String() string { return proto.CompactTextString(m) }
+func (*ConsumedJWT) ProtoMessage()    {}
+func (*ConsumedJWT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62d46436c8ace0df, []int{0}
+}
+func (m *ConsumedJWT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumedJWT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumedJWT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumedJWT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumedJWT.Merge(m, src)
+}
+func (m *ConsumedJWT) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumedJWT) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumedJWT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumedJWT proto.InternalMessageInfo
+
+func (m *ConsumedJWT) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *ConsumedJWT) GetAud() string {
+	if m != nil {
+		return m.Aud
+	}
+	return ""
+}
+
+func (m *ConsumedJWT) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *ConsumedJWT) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*ConsumedJWT)(nil), "xion.jwk.v1.ConsumedJWT")
+}
+
+func init() { proto.RegisterFile("xion/jwk/v1/jwt.proto", fileDescriptor_62d46436c8ace0df) }
+
+var fileDescriptor_62d46436c8ace0df = []byte{
+	// 274 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x18, 0x84, 0x63, 0x8a, 0x4a, 0x71, 0x16, 0x14, 0x01, 0x0a, 0x19, 0x9c, 0x8a, 0x01, 0x75, 0xc1,
+	0x56, 0xe1, 0x05, 0x50, 0x61, 0x81, 0xb1, 0xaa, 0x84, 0xc4, 0x82, 0x12, 0x62, 0x8c, 0x93, 0xc6,
+	0x7f, 0x94, 0x38, 0x4d, 0x78, 0x8b, 0x8e, 0x3c, 0x52, 0xc7, 0x8e, 0x4c, 0x80, 0x92, 0x17, 0x41,
+	0x71, 0xa8, 0xc4, 0x76, 0xf7, 0xe9, 0x7e, 0xfd, 0xa7, 0xc3, 0x27, 0xb5, 0x04, 0xc5, 0xe2, 0x2a,
+	0x61, 0xab, 0x29, 0x8b, 0x2b, 0x4d, 0xb3, 0x1c, 0x34, 0x38, 0x76, 0x87, 0x69, 0x5c, 0x25, 0x74,
+	0x35, 0xf5, 0x8e, 0x05, 0x08, 0x30, 0x9c, 0x75, 0xaa, 0x8f, 0x78, 0xbe, 0x00, 0x10, 0x4b, 0xce,
+	0x8c, 0x0b, 0xcb, 0x57, 0xa6, 0x65, 0xca, 0x0b, 0x1d, 0xa4, 0x59, 0x1f, 0x38, 0xff, 0x40, 0xd8,
+	0xbe, 0x05, 0x55, 0x94, 0x29, 0x8f, 0x1e, 0x1e, 0x17, 0xce, 0x29, 0x1e, 0x16, 0x52, 0x28, 0x9e,
+	0xbb, 0x68, 0x8c, 0x26, 0x87, 0xf3, 0x3f, 0xe7, 0x1c, 0xe1, 0x41, 0x50, 0x46, 0xee, 0x9e, 0x81,
+	0x9d, 0x74, 0xce, 0xf0, 0x48, 0x43, 0xc2, 0xd5, 0xb3, 0x8c, 0xdc, 0x81, 0xc1, 0x07, 0xc6, 0xdf,
+	0x47, 0xce, 0x1d, 0xc6, 0xbc, 0xce, 0x64, 0x1e, 0x68, 0x09, 0xca, 0xdd, 0x1f, 0xa3, 0x89, 0x7d,
+	0xe5, 0xd1, 0xbe, 0x0a, 0xdd, 0x55, 0xa1, 0x8b, 0x5d, 0x95, 0xd9, 0x68, 0xf3, 0xe5, 0x5b, 0xeb,
+	0x6f, 0x1f, 0xcd, 0xff, 0xdd, 0xcd, 0x6e, 0x36, 0x0d, 0x41, 0xdb, 0x86, 0xa0, 0x9f, 0x86, 0xa0,
+	0x75, 0x4b, 0xac, 0x6d, 0x4b, 0xac, 0xcf, 0x96, 0x58, 0x4f, 0x17, 0x42, 0xea, 0xb7, 0x32, 0xa4,
+	0x2f, 0x90, 0xb2, 0xb0, 0xcc, 0x95, 0xbe, 0x5c, 0x06, 0x61, 0xc1, 0xcc, 0x4a, 0xb5, 0xd9, 0x49,
+	0xbf, 0x67, 0xbc, 0x08, 0x87, 0xe6, 0xd7, 0xf5, 0xef, 0x00, 0xd4, 0x30, 0xd3, 0x26, 0x40, 0x01,
+	0x00, 0x00,
+}
+
+func (m *ConsumedJWT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumedJWT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumedJWT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintJwt(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintJwt(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintJwt(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintJwt(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintJwt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJwt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConsumedJWT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovJwt(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovJwt(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovJwt(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovJwt(uint64(l))
+	return n
+}
+
+func sovJwt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJwt(x uint64) (n int) {
+	return sovJwt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConsumedJWT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJwt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumedJWT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumedJWT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJwt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJwt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJwt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJwt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJwt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJwt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aud = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJwt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJwt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJwt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJwt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJwt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJwt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJwt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJwt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipJwt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowJwt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJwt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowJwt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthJwt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupJwt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthJwt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthJwt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowJwt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupJwt = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,6 +1,11 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var _ binary.ByteOrder
 
@@ -8,8 +13,16 @@ const (
 	// AudienceKeyPrefix is the prefix to retrieve all Audience
 	AudienceKeyPrefix = "Audience/value/"
 
+	// AudienceHashKeyPrefix is the prefix of the audiences by the hash of
+	// their aud, the one of their claim
+	AudienceHashKeyPrefix = "Audience/hash/"
+
 	// AudienceClaimKeyPrefix is the prefix for audience claims
 	AudienceClaimKeyPrefix = "AudienceClaim/value/"
+
+	// AudienceClaimExpiryKeyPrefix is the prefix of unused audience claims by
+	// their expiration
+	AudienceClaimExpiryKeyPrefix = "AudienceClaim/expiry/"
 )
 
 // AudienceKey returns the store key to retrieve an Audience from the index fields
@@ -25,6 +38,12 @@ func AudienceKey(
 	return key
 }
 
+// AudienceHashKey returns the key of an audience in the index by the hash of
+// its aud
+func AudienceHashKey(hash []byte) []byte {
+	return append(append([]byte{}, hash...), '/')
+}
+
 func AudienceClaimKey(hash []byte) []byte {
 	var key []byte
 
@@ -33,3 +52,9 @@ func AudienceClaimKey(hash []byte) []byte {
 
 	return key
 }
+
+// AudienceClaimExpiryKey returns the key of an audience claim in the expiry
+// index, sorted by expiration
+func AudienceClaimExpiryKey(expiration time.Time, hash []byte) []byte {
+	return append(sdk.FormatTimeBytes(expiration), hash...)
+}
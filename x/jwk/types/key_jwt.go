@@ -0,0 +1,37 @@
+package types
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ConsumedJWTKeyPrefix is the prefix of consumed tokens
+	ConsumedJWTKeyPrefix = "ConsumedJWT/value/"
+
+	// ConsumedJWTExpiryKeyPrefix is the prefix of consumed tokens by their
+	// expiration, to prune them once they expire
+	ConsumedJWTExpiryKeyPrefix = "ConsumedJWT/expiry/"
+)
+
+// ConsumedJWTKey returns the store key of a token consumed by signer. The aud
+// and token id are hashed to give the key fixed length parts.
+func ConsumedJWTKey(signer sdk.AccAddress, aud, tokenID string) []byte {
+	var key []byte
+
+	audHash := sha256.Sum256([]byte(aud))
+	tokenHash := sha256.Sum256([]byte(tokenID))
+	key = append(key, audHash[:]...)
+	key = append(key, tokenHash[:]...)
+	key = append(key, signer...)
+
+	return key
+}
+
+// ConsumedJWTExpiryKey returns the key of a consumed token in the expiry
+// index, sorted by expiration
+func ConsumedJWTExpiryKey(expiration time.Time, key []byte) []byte {
+	return append(sdk.FormatTimeBytes(expiration), key...)
+}
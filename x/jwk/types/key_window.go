@@ -0,0 +1,122 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewKeyWindow creates the validity window of an audience key, either bound
+// being optional
+func NewKeyWindow(kid string, notBefore, notAfter *time.Time) KeyWindow {
+	return KeyWindow{
+		Kid:       kid,
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+	}
+}
+
+// IsUnbounded returns true if the window does not restrict its key
+func (w KeyWindow) IsUnbounded() bool {
+	return w.NotBefore == nil && w.NotAfter == nil
+}
+
+func (w KeyWindow) Validate() error {
+	if w.Kid == "" {
+		return errorsmod.Wrap(ErrInvalidKeyWindow, "kid is required")
+	}
+
+	if w.NotBefore != nil && w.NotAfter != nil && !w.NotAfter.After(*w.NotBefore) {
+		return errorsmod.Wrapf(ErrInvalidKeyWindow, "not after %s must be after not before %s", w.NotAfter, w.NotBefore)
+	}
+
+	return nil
+}
+
+// Check returns an error if the key is not valid at t
+func (w KeyWindow) Check(t time.Time) error {
+	if w.NotBefore != nil && t.Before(*w.NotBefore) {
+		return errorsmod.Wrapf(ErrKeyNotActive, "key %s is valid from %s", w.Kid, w.NotBefore)
+	}
+
+	if w.NotAfter != nil && !t.Before(*w.NotAfter) {
+		return errorsmod.Wrapf(ErrKeyNotActive, "key %s expired at %s", w.Kid, w.NotAfter)
+	}
+
+	return nil
+}
+
+// GetKeyWindow returns the validity window of the audience key with kid
+func (a Audience) GetKeyWindow(kid string) (KeyWindow, bool) {
+	for _, window := range a.KeyWindows {
+		if window.Kid == kid {
+			return window, true
+		}
+	}
+
+	return KeyWindow{}, false
+}
+
+// SetKeyWindow sets the validity window of an audience key, an unbounded
+// window removes it. Windows are kept sorted by kid.
+func (a *Audience) SetKeyWindow(window KeyWindow) {
+	windows := make([]KeyWindow, 0, len(a.KeyWindows)+1)
+	for _, existing := range a.KeyWindows {
+		if existing.Kid != window.Kid {
+			windows = append(windows, existing)
+		}
+	}
+
+	if !window.IsUnbounded() {
+		windows = append(windows, window)
+	}
+
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Kid < windows[j].Kid
+	})
+
+	a.KeyWindows = windows
+}
+
+// PruneKeyWindows removes the windows of keys that are no longer part of the
+// audience key
+func (a *Audience) PruneKeyWindows() error {
+	if len(a.KeyWindows) == 0 {
+		return nil
+	}
+
+	set, err := ParseAudienceKeys(a.Key)
+	if err != nil {
+		return err
+	}
+
+	windows := make([]KeyWindow, 0, len(a.KeyWindows))
+	for _, window := range a.KeyWindows {
+		if _, found := set.LookupKeyID(window.Kid); found {
+			windows = append(windows, window)
+		}
+	}
+	a.KeyWindows = windows
+
+	return nil
+}
+
+// ValidateKeyWindows checks the windows are valid and unique per kid
+func (a Audience) ValidateKeyWindows() error {
+	seen := make(map[string]bool)
+	for _, window := range a.KeyWindows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+		if window.IsUnbounded() {
+			return errorsmod.Wrapf(ErrInvalidKeyWindow, "unbounded window for key %s", window.Kid)
+		}
+		if seen[window.Kid] {
+			return errorsmod.Wrapf(ErrInvalidKeyWindow, "duplicate window for key %s", window.Kid)
+		}
+		seen[window.Kid] = true
+	}
+
+	return nil
+}
@@ -11,6 +11,9 @@ const (
 	RouterKey = ModuleName
 )
 
+// ParamsKey is the store key of the module params
+var ParamsKey = []byte("Params/value/")
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -1,10 +1,7 @@
 package types
 
 import (
-	"fmt"
-
-	"github.com/lestrrat-go/jwx/v2/jwa"
-	"github.com/lestrrat-go/jwx/v2/jwk"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -19,6 +16,11 @@ const (
 
 	TypeMsgCreateAudienceClaim = "create_audience_claim"
 	TypeMsgDeleteAudienceClaim = "delete_audience_claim"
+
+	TypeMsgAddAudienceKey    = "add_audience_key"
+	TypeMsgRemoveAudienceKey = "remove_audience_key"
+
+	TypeMsgSetAudienceKeyWindow = "set_audience_key_window"
 )
 
 var _ sdk.Msg = &MsgCreateAudience{}
@@ -27,11 +29,15 @@ func NewMsgCreateAudience(
 	admin string,
 	aud string,
 	key string,
+	issuer string,
+	authorizedParty string,
 ) *MsgCreateAudience {
 	return &MsgCreateAudience{
-		Admin: admin,
-		Aud:   aud,
-		Key:   key,
+		Admin:           admin,
+		Aud:             aud,
+		Key:             key,
+		Issuer:          issuer,
+		AuthorizedParty: authorizedParty,
 	}
 }
 
@@ -62,21 +68,10 @@ func (msg *MsgCreateAudience) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
 	}
 
-	key, err := jwk.ParseKey([]byte(msg.Key))
-	if err != nil {
-		return errorsmod.Wrapf(ErrInvalidJWK, "invalid jwk format (%s)", err)
-	}
-
-	var sigAlg jwa.SignatureAlgorithm
-	if err := sigAlg.Accept(key.Algorithm().String()); err != nil {
+	if _, err := ParseAudienceKeys(msg.Key); err != nil {
 		return err
 	}
 
-	switch sigAlg {
-	case jwa.HS256, jwa.HS384, jwa.HS512, jwa.NoSignature:
-		return fmt.Errorf("invalid algorithm: %s", sigAlg.String())
-	}
-
 	return nil
 }
 
@@ -87,12 +82,16 @@ func NewMsgUpdateAudience(
 	newAdmin string,
 	aud string,
 	key string,
+	issuer string,
+	authorizedParty string,
 ) *MsgUpdateAudience {
 	return &MsgUpdateAudience{
-		NewAdmin: newAdmin,
-		Admin:    admin,
-		Aud:      aud,
-		Key:      key,
+		NewAdmin:        newAdmin,
+		Admin:           admin,
+		Aud:             aud,
+		Key:             key,
+		Issuer:          issuer,
+		AuthorizedParty: authorizedParty,
 	}
 }
 
@@ -128,21 +127,10 @@ func (msg *MsgUpdateAudience) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
 	}
 
-	key, err := jwk.ParseKey([]byte(msg.Key))
-	if err != nil {
-		return errorsmod.Wrapf(ErrInvalidJWK, "invalid jwk format (%s)", err)
-	}
-
-	var sigAlg jwa.SignatureAlgorithm
-	if err := sigAlg.Accept(key.Algorithm().String()); err != nil {
+	if _, err := ParseAudienceKeys(msg.Key); err != nil {
 		return err
 	}
 
-	switch sigAlg {
-	case jwa.HS256, jwa.HS384, jwa.HS512, jwa.NoSignature:
-		return fmt.Errorf("invalid algorithm: %s", sigAlg.String())
-	}
-
 	return nil
 }
 
@@ -274,3 +262,152 @@ func (msg *MsgDeleteAudienceClaim) ValidateBasic() error {
 
 	return nil
 }
+
+var _ sdk.Msg = &MsgAddAudienceKey{}
+
+func NewMsgAddAudienceKey(
+	admin string,
+	aud string,
+	key string,
+	notBefore *time.Time,
+	notAfter *time.Time,
+) *MsgAddAudienceKey {
+	return &MsgAddAudienceKey{
+		Admin:     admin,
+		Aud:       aud,
+		Key:       key,
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+	}
+}
+
+func (msg *MsgAddAudienceKey) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddAudienceKey) Type() string {
+	return TypeMsgAddAudienceKey
+}
+
+func (msg *MsgAddAudienceKey) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+func (msg *MsgAddAudienceKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddAudienceKey) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+
+	key, err := ParseAudienceKey(msg.Key)
+	if err != nil {
+		return err
+	}
+
+	return NewKeyWindow(key.KeyID(), msg.NotBefore, msg.NotAfter).Validate()
+}
+
+var _ sdk.Msg = &MsgRemoveAudienceKey{}
+
+func NewMsgRemoveAudienceKey(
+	admin string,
+	aud string,
+	kid string,
+) *MsgRemoveAudienceKey {
+	return &MsgRemoveAudienceKey{
+		Admin: admin,
+		Aud:   aud,
+		Kid:   kid,
+	}
+}
+
+func (msg *MsgRemoveAudienceKey) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveAudienceKey) Type() string {
+	return TypeMsgRemoveAudienceKey
+}
+
+func (msg *MsgRemoveAudienceKey) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+func (msg *MsgRemoveAudienceKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveAudienceKey) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+
+	if msg.Kid == "" {
+		return errorsmod.Wrap(ErrUnknownKeyID, "kid is required")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetAudienceKeyWindow{}
+
+func NewMsgSetAudienceKeyWindow(
+	admin string,
+	aud string,
+	kid string,
+	notBefore *time.Time,
+	notAfter *time.Time,
+) *MsgSetAudienceKeyWindow {
+	return &MsgSetAudienceKeyWindow{
+		Admin:     admin,
+		Aud:       aud,
+		Kid:       kid,
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+	}
+}
+
+func (msg *MsgSetAudienceKeyWindow) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetAudienceKeyWindow) Type() string {
+	return TypeMsgSetAudienceKeyWindow
+}
+
+func (msg *MsgSetAudienceKeyWindow) GetSigners() []sdk.AccAddress {
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{admin}
+}
+
+func (msg *MsgSetAudienceKeyWindow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetAudienceKeyWindow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address (%s)", err)
+	}
+
+	return NewKeyWindow(msg.Kid, msg.NotBefore, msg.NotAfter).Validate()
+}
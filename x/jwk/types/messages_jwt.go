@@ -0,0 +1,64 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgConsumeJWT = "consume_jwt"
+
+var _ sdk.Msg = &MsgConsumeJWT{}
+
+func NewMsgConsumeJWT(
+	signer string,
+	aud string,
+	sub string,
+	sigBytes string,
+) *MsgConsumeJWT {
+	return &MsgConsumeJWT{
+		Signer:   signer,
+		Aud:      aud,
+		Sub:      sub,
+		SigBytes: sigBytes,
+	}
+}
+
+func (msg *MsgConsumeJWT) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgConsumeJWT) Type() string {
+	return TypeMsgConsumeJWT
+}
+
+func (msg *MsgConsumeJWT) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgConsumeJWT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgConsumeJWT) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if msg.Aud == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "aud is required")
+	}
+
+	if msg.SigBytes == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "sig bytes are required")
+	}
+
+	return nil
+}
@@ -1,29 +1,21 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
-)
-
-var _ paramtypes.ParamSet = (*Params)(nil)
-
-var (
-	ParamStoreKeyTimeOffset    = []byte("TimeOffset")
-	ParamStoreKeyDeploymentGas = []byte("DeploymentGas")
 )
 
-// ParamKeyTable the param key table for launch module
-func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
-}
-
 // NewParams creates a new Params instance
-func NewParams(timeOffset, deploymentGas uint64) Params {
+func NewParams(timeOffset, deploymentGas uint64, claimDeposit sdk.Coins, claimExpiration time.Duration) Params {
 	return Params{
-		TimeOffset:    timeOffset,
-		DeploymentGas: deploymentGas,
+		TimeOffset:      timeOffset,
+		DeploymentGas:   deploymentGas,
+		ClaimDeposit:    claimDeposit,
+		ClaimExpiration: claimExpiration,
 	}
 }
 
@@ -32,15 +24,20 @@ func DefaultParams() Params {
 	deploymentGas := uint64(10_000)
 	timeOffset := uint64(30 * 1000) // default to 30 seconds
 
-	return NewParams(timeOffset, deploymentGas)
+	return NewParams(timeOffset, deploymentGas, DefaultClaimDeposit(), DefaultClaimExpiration)
 }
 
-// ParamSetPairs get the params.ParamSet
-func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(ParamStoreKeyDeploymentGas, &p.DeploymentGas, validateDeploymentGas),
-		paramtypes.NewParamSetPair(ParamStoreKeyTimeOffset, &p.TimeOffset, validateTimeOffset),
-	}
+// MaxTimeOffset bounds the time offset, tokens are validated at most that far
+// ahead of the block time
+const MaxTimeOffset = uint64(10 * time.Minute)
+
+// DefaultClaimExpiration is how long a claim may stay unused by default
+const DefaultClaimExpiration = 30 * 24 * time.Hour
+
+// DefaultClaimDeposit returns no deposit, the deposit is in the chain's fee
+// denom and is set by governance
+func DefaultClaimDeposit() sdk.Coins {
+	return sdk.NewCoins()
 }
 
 func validateDeploymentGas(i interface{}) error {
@@ -53,11 +50,37 @@ func validateDeploymentGas(i interface{}) error {
 }
 
 func validateTimeOffset(i interface{}) error {
-	_, ok := i.(uint64)
+	timeOffset, ok := i.(uint64)
 	if !ok {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected uint64", i)
 	}
 
+	if timeOffset > MaxTimeOffset {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "time offset %s is more than %s", time.Duration(timeOffset), time.Duration(MaxTimeOffset))
+	}
+
+	return nil
+}
+
+func validateClaimDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coins)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected sdk.Coins", i)
+	}
+
+	return deposit.Validate()
+}
+
+func validateClaimExpiration(i interface{}) error {
+	expiration, ok := i.(time.Duration)
+	if !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidType, "type: %T, expected time.Duration", i)
+	}
+
+	if expiration < 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "claim expiration cannot be negative")
+	}
+
 	return nil
 }
 
@@ -67,5 +90,13 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateTimeOffset(p.TimeOffset)
+	if err := validateTimeOffset(p.TimeOffset); err != nil {
+		return err
+	}
+
+	if err := validateClaimDeposit(p.ClaimDeposit); err != nil {
+		return err
+	}
+
+	return validateClaimExpiration(p.ClaimExpiration)
 }
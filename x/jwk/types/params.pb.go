@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +33,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	TimeOffset    uint64 `protobuf:"varint,1,opt,name=time_offset,json=timeOffset,proto3" json:"time_offset,omitempty" yaml:"time_offset"`
 	DeploymentGas uint64 `protobuf:"varint,2,opt,name=deployment_gas,json=deploymentGas,proto3" json:"deployment_gas,omitempty" yaml:"deployment_gas"`
+	// claim_deposit is held for each audience claim and refunded when the
+	// claim is deleted
+	ClaimDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=claim_deposit,json=claimDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claim_deposit" yaml:"claim_deposit"`
+	// claim_expiration is how long a claim may stay without an audience before
+	// it expires and its deposit goes to the community pool, zero to never
+	// expire claims
+	ClaimExpiration time.Duration `protobuf:"bytes,4,opt,name=claim_expiration,json=claimExpiration,proto3,stdduration" json:"claim_expiration" yaml:"claim_expiration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -76,6 +89,20 @@ func (m *Params) GetDeploymentGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetClaimDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetClaimExpiration() time.Duration {
+	if m != nil {
+		return m.ClaimExpiration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "xion.jwk.v1.Params")
 }
@@ -83,22 +110,32 @@ func init() {
 func init() { proto.RegisterFile("xion/jwk/v1/params.proto", fileDescriptor_6d05e32b718278f0) }
 
 var fileDescriptor_6d05e32b718278f0 = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xa8, 0xc8, 0xcc, 0xcf,
-	0xd3, 0xcf, 0x2a, 0xcf, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x06, 0xc9, 0xe8, 0x65, 0x95, 0x67, 0xeb, 0x95, 0x19, 0x4a,
-	0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xc5, 0xf5, 0x41, 0x2c, 0x88, 0x12, 0xa5, 0x66, 0x46, 0x2e,
-	0xb6, 0x00, 0xb0, 0x1e, 0x21, 0x73, 0x2e, 0xee, 0x92, 0xcc, 0xdc, 0xd4, 0xf8, 0xfc, 0xb4, 0xb4,
-	0xe2, 0xd4, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x16, 0x27, 0xb1, 0x4f, 0xf7, 0xe4, 0x85, 0x2a,
-	0x13, 0x73, 0x73, 0xac, 0x94, 0x90, 0x24, 0x95, 0x82, 0xb8, 0x40, 0x3c, 0x7f, 0x30, 0x47, 0xc8,
-	0x81, 0x8b, 0x2f, 0x25, 0xb5, 0x20, 0x27, 0xbf, 0x32, 0x37, 0x35, 0xaf, 0x24, 0x3e, 0x3d, 0xb1,
-	0x58, 0x82, 0x09, 0xac, 0x57, 0xf2, 0xd3, 0x3d, 0x79, 0x51, 0x88, 0x5e, 0x54, 0x79, 0xa5, 0x20,
-	0x5e, 0x84, 0x80, 0x7b, 0x62, 0xb1, 0x93, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
-	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
-	0x31, 0x44, 0xa9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0x95,
-	0x16, 0xe5, 0x95, 0xe8, 0xe6, 0x24, 0x26, 0x15, 0xeb, 0x83, 0xbd, 0x5c, 0x01, 0xf6, 0x74, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x3b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x58,
-	0xb3, 0x8e, 0x67, 0x0d, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0xbf, 0xce, 0xd3, 0x30,
+	0x1c, 0x8c, 0xbf, 0xef, 0x53, 0x87, 0x94, 0x02, 0x8a, 0x0a, 0xa4, 0x1d, 0x92, 0x2a, 0x48, 0xa8,
+	0x4b, 0x6d, 0x05, 0x06, 0x24, 0xa6, 0x2a, 0x14, 0xc1, 0x06, 0xca, 0xc8, 0x52, 0x39, 0x89, 0x1b,
+	0xdc, 0x26, 0x71, 0x14, 0x3b, 0xfd, 0xf3, 0x06, 0x8c, 0x8c, 0x3c, 0x03, 0x4f, 0xd2, 0xb1, 0x23,
+	0x53, 0x8a, 0xda, 0x37, 0xe8, 0xc6, 0x86, 0x62, 0xa7, 0xb4, 0x9d, 0x92, 0xdf, 0xfd, 0x7c, 0xe7,
+	0xbb, 0x93, 0x75, 0x73, 0x4d, 0x59, 0x86, 0xe6, 0xab, 0x05, 0x5a, 0xba, 0x28, 0xc7, 0x05, 0x4e,
+	0x39, 0xcc, 0x0b, 0x26, 0x98, 0xd1, 0xae, 0x37, 0x70, 0xbe, 0x5a, 0xc0, 0xa5, 0xdb, 0xef, 0xc6,
+	0x2c, 0x66, 0x12, 0x47, 0xf5, 0x9f, 0x3a, 0xd2, 0xb7, 0x42, 0xc6, 0x53, 0xc6, 0x51, 0x80, 0x39,
+	0x41, 0x4b, 0x37, 0x20, 0x02, 0xbb, 0x28, 0x64, 0x34, 0x3b, 0xef, 0x63, 0xc6, 0xe2, 0x84, 0x20,
+	0x39, 0x05, 0xe5, 0x0c, 0x45, 0x65, 0x81, 0x45, 0x2d, 0x2b, 0x11, 0xe7, 0xef, 0x9d, 0xde, 0xfa,
+	0x22, 0xef, 0x34, 0xde, 0xea, 0x6d, 0x41, 0x53, 0x32, 0x65, 0xb3, 0x19, 0x27, 0xc2, 0x04, 0x03,
+	0x30, 0x7c, 0xf0, 0x9e, 0x9f, 0x2a, 0xdb, 0xd8, 0xe0, 0x34, 0x79, 0xe7, 0x5c, 0x2d, 0x1d, 0x5f,
+	0xaf, 0xa7, 0xcf, 0x72, 0x30, 0xc6, 0xfa, 0xe3, 0x88, 0xe4, 0x09, 0xdb, 0xa4, 0x24, 0x13, 0xd3,
+	0x18, 0x73, 0xf3, 0x4e, 0x72, 0x7b, 0xa7, 0xca, 0x7e, 0xa6, 0xb8, 0xb7, 0x7b, 0xc7, 0xef, 0x5c,
+	0x80, 0x8f, 0x98, 0x1b, 0xdf, 0x81, 0xde, 0x09, 0x13, 0x4c, 0xd3, 0x69, 0x44, 0x72, 0xc6, 0xa9,
+	0x30, 0xef, 0x07, 0xf7, 0xc3, 0xf6, 0xeb, 0x1e, 0x54, 0xf1, 0x60, 0x1d, 0x0f, 0x36, 0xf1, 0xe0,
+	0x7b, 0x46, 0x33, 0xef, 0xd3, 0xb6, 0xb2, 0xb5, 0x53, 0x65, 0x77, 0xd5, 0x05, 0x37, 0x6c, 0xe7,
+	0xd7, 0xde, 0x1e, 0xc6, 0x54, 0x7c, 0x2b, 0x03, 0x18, 0xb2, 0x14, 0x35, 0x1d, 0xa9, 0xcf, 0x88,
+	0x47, 0x0b, 0x24, 0x36, 0x39, 0xe1, 0x52, 0x88, 0xfb, 0x8f, 0x24, 0x77, 0xa2, 0xa8, 0x06, 0xd5,
+	0x9f, 0x2a, 0x2d, 0xb2, 0xce, 0xa9, 0xaa, 0xca, 0x7c, 0x18, 0x00, 0x69, 0x46, 0x75, 0x09, 0xcf,
+	0x5d, 0xc2, 0x49, 0xd3, 0xa5, 0xf7, 0xb2, 0x31, 0xf3, 0xe2, 0xda, 0xcc, 0x45, 0xc0, 0xf9, 0xb9,
+	0xb7, 0x81, 0xff, 0x44, 0xc2, 0x1f, 0xfe, 0xa3, 0xde, 0x78, 0x7b, 0xb0, 0xc0, 0xee, 0x60, 0x81,
+	0x3f, 0x07, 0x0b, 0xfc, 0x38, 0x5a, 0xda, 0xee, 0x68, 0x69, 0xbf, 0x8f, 0x96, 0xf6, 0xf5, 0xd5,
+	0x95, 0xf9, 0xa0, 0x2c, 0x32, 0x31, 0x4a, 0x70, 0xc0, 0x91, 0x7c, 0x28, 0x6b, 0xf9, 0x54, 0x64,
+	0x80, 0xa0, 0x25, 0xad, 0xbc, 0xf9, 0x37, 0x00, 0x1f, 0x58, 0x37, 0x72, 0x43, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +158,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClaimExpiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClaimExpiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ClaimDeposit) > 0 {
+		for iNdEx := len(m.ClaimDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DeploymentGas != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeploymentGas))
 		i--
@@ -157,6 +216,14 @@ func (m *Params) Size() (n int) {
 	if m.DeploymentGas != 0 {
 		n += 1 + sovParams(uint64(m.DeploymentGas))
 	}
+	if len(m.ClaimDeposit) > 0 {
+		for _, e := range m.ClaimDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClaimExpiration)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -233,6 +300,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimDeposit = append(m.ClaimDeposit, types.Coin{})
+			if err := m.ClaimDeposit[len(m.ClaimDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimExpiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClaimExpiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,37 @@
+/*
+NOTE: Usage of x/params to manage parameters is deprecated in favor of x/gov
+controlled execution of MsgUpdateParams messages. These types remains solely
+for migration purposes and will be removed in a future release.
+*/
+package types
+
+import (
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// Parameter store keys
+var (
+	ParamStoreKeyTimeOffset      = []byte("TimeOffset")
+	ParamStoreKeyDeploymentGas   = []byte("DeploymentGas")
+	ParamStoreKeyClaimDeposit    = []byte("ClaimDeposit")
+	ParamStoreKeyClaimExpiration = []byte("ClaimExpiration")
+)
+
+// Deprecated: ParamKeyTable for jwk module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet
+//
+// Deprecated.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyDeploymentGas, &p.DeploymentGas, validateDeploymentGas),
+		paramtypes.NewParamSetPair(ParamStoreKeyTimeOffset, &p.TimeOffset, validateTimeOffset),
+		paramtypes.NewParamSetPair(ParamStoreKeyClaimDeposit, &p.ClaimDeposit, validateClaimDeposit),
+		paramtypes.NewParamSetPair(ParamStoreKeyClaimExpiration, &p.ClaimExpiration, validateClaimExpiration),
+	}
+}
@@ -446,8 +446,15 @@ func (m *QueryValidateJWTRequest) GetSigBytes() string {
 }
 
 type PrivateClaim struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the claim for string claims and its json_value otherwise
 	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// type is the JSON type of the claim: string, number, boolean, array,
+	// object or null
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// json_value is the claim as canonical JSON, with sorted object keys and
+	// numbers as in the token
+	JsonValue string `protobuf:"bytes,4,opt,name=json_value,json=jsonValue,proto3" json:"json_value,omitempty"`
 }
 
 func (m *PrivateClaim) Reset()         { *m = PrivateClaim{} }
@@ -497,8 +504,29 @@ func (m *PrivateClaim) GetValue() string {
 	return ""
 }
 
+func (m *PrivateClaim) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PrivateClaim) GetJsonValue() string {
+	if m != nil {
+		return m.JsonValue
+	}
+	return ""
+}
+
 type QueryValidateJWTResponse struct {
 	PrivateClaims []*PrivateClaim `protobuf:"bytes,1,rep,name=privateClaims,proto3" json:"privateClaims,omitempty"`
+	// the registered claims of the token, zero when absent. Times are unix
+	// seconds.
+	Iss string `protobuf:"bytes,2,opt,name=iss,proto3" json:"iss,omitempty"`
+	Iat int64  `protobuf:"varint,3,opt,name=iat,proto3" json:"iat,omitempty"`
+	Exp int64  `protobuf:"varint,4,opt,name=exp,proto3" json:"exp,omitempty"`
+	Nbf int64  `protobuf:"varint,5,opt,name=nbf,proto3" json:"nbf,omitempty"`
+	Jti string `protobuf:"bytes,6,opt,name=jti,proto3" json:"jti,omitempty"`
 }
 
 func (m *QueryValidateJWTResponse) Reset()         { *m = QueryValidateJWTResponse{} }
@@ -541,6 +569,41 @@ func (m *QueryValidateJWTResponse) GetPrivateClaims() []*PrivateClaim {
 	return nil
 }
 
+func (m *QueryValidateJWTResponse) GetIss() string {
+	if m != nil {
+		return m.Iss
+	}
+	return ""
+}
+
+func (m *QueryValidateJWTResponse) GetIat() int64 {
+	if m != nil {
+		return m.Iat
+	}
+	return 0
+}
+
+func (m *QueryValidateJWTResponse) GetExp() int64 {
+	if m != nil {
+		return m.Exp
+	}
+	return 0
+}
+
+func (m *QueryValidateJWTResponse) GetNbf() int64 {
+	if m != nil {
+		return m.Nbf
+	}
+	return 0
+}
+
+func (m *QueryValidateJWTResponse) GetJti() string {
+	if m != nil {
+		return m.Jti
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "xion.jwk.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "xion.jwk.v1.QueryParamsResponse")
@@ -558,52 +621,56 @@ func init() {
 func init() { proto.RegisterFile("xion/jwk/v1/query.proto", fileDescriptor_6aa237fef6ed9f02) }
 
 var fileDescriptor_6aa237fef6ed9f02 = []byte{
-	// 708 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x4f, 0xd4, 0x4e,
-	0x18, 0xdd, 0x02, 0x4b, 0x60, 0x16, 0x12, 0x32, 0xec, 0x2f, 0xec, 0xaf, 0xc1, 0x05, 0x27, 0x0a,
-	0x8a, 0xa1, 0xe3, 0x62, 0xd4, 0xa3, 0x82, 0x89, 0x18, 0x4f, 0x50, 0x8d, 0x46, 0x3d, 0x90, 0xe9,
-	0xee, 0xa4, 0x94, 0xed, 0x76, 0xca, 0xce, 0xb4, 0xb0, 0x21, 0x5c, 0xbc, 0x78, 0x54, 0xe3, 0xd5,
-	0x3f, 0x88, 0x23, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x7f, 0x88, 0xe9, 0xcc, 0x74, 0x69, 0xd9, 0xae,
-	0x70, 0xd9, 0x4c, 0x67, 0xde, 0xf7, 0xde, 0xeb, 0xf7, 0xcd, 0xdb, 0x82, 0xb9, 0x43, 0x8f, 0x05,
-	0x78, 0xef, 0xa0, 0x8d, 0xe3, 0x06, 0xde, 0x8f, 0x68, 0xb7, 0x67, 0x85, 0x5d, 0x26, 0x18, 0xac,
-	0x24, 0x07, 0xd6, 0xde, 0x41, 0xdb, 0x8a, 0x1b, 0x66, 0xd5, 0x65, 0x2e, 0x93, 0xfb, 0x38, 0x59,
-	0x29, 0x88, 0x39, 0xef, 0x32, 0xe6, 0xfa, 0x14, 0x93, 0xd0, 0xc3, 0x24, 0x08, 0x98, 0x20, 0xc2,
-	0x63, 0x01, 0xd7, 0xa7, 0x2b, 0x4d, 0xc6, 0x3b, 0x8c, 0x63, 0x87, 0x70, 0xaa, 0x98, 0x71, 0xdc,
-	0x70, 0xa8, 0x20, 0x0d, 0x1c, 0x12, 0xd7, 0x0b, 0x24, 0x58, 0x63, 0x6b, 0x59, 0x17, 0x21, 0xe9,
-	0x92, 0x4e, 0xca, 0x62, 0x66, 0x4f, 0x48, 0xd4, 0xf2, 0x68, 0xd0, 0xa4, 0xea, 0x0c, 0x55, 0x01,
-	0xdc, 0x4e, 0x78, 0xb7, 0x64, 0x81, 0x4d, 0xf7, 0x23, 0xca, 0x05, 0x7a, 0x01, 0x66, 0x73, 0xbb,
-	0x3c, 0x64, 0x01, 0xa7, 0xb0, 0x01, 0xc6, 0x15, 0x71, 0xcd, 0x58, 0x34, 0xee, 0x54, 0xd6, 0x66,
-	0xad, 0xcc, 0x0b, 0x5a, 0x0a, 0xbc, 0x31, 0x76, 0xf2, 0x6b, 0xa1, 0x64, 0x6b, 0x20, 0x5a, 0x03,
-	0xf3, 0x92, 0x69, 0x93, 0x8a, 0x75, 0xad, 0xfc, 0xcc, 0x27, 0x5e, 0x47, 0x2b, 0x41, 0x08, 0xc6,
-	0x76, 0x09, 0xdf, 0x95, 0x84, 0x53, 0xb6, 0x5c, 0xa3, 0x6d, 0x70, 0x63, 0x48, 0x8d, 0xf6, 0x71,
-	0x1f, 0x94, 0x9b, 0xc9, 0x86, 0xb6, 0x61, 0xe6, 0x6c, 0xe4, 0x4b, 0x14, 0x10, 0xdd, 0x03, 0x73,
-	0x97, 0x29, 0x53, 0x07, 0x33, 0x60, 0x94, 0x44, 0x2d, 0x49, 0x35, 0x69, 0x27, 0x4b, 0xf4, 0x0a,
-	0xd4, 0x06, 0xc1, 0x5a, 0xfa, 0x31, 0x98, 0x48, 0x3b, 0xa8, 0xd5, 0xff, 0x2b, 0x54, 0xd7, 0x6d,
-	0xe8, 0x83, 0x11, 0xd1, 0x0e, 0xd6, 0x7d, 0xff, 0xb2, 0x83, 0xe7, 0x00, 0x5c, 0x4c, 0x53, 0xb3,
-	0x2e, 0x59, 0x6a, 0xf4, 0x56, 0x32, 0x7a, 0x4b, 0x5d, 0x2a, 0x3d, 0x7a, 0x6b, 0x8b, 0xb8, 0x69,
-	0xad, 0x9d, 0xa9, 0x44, 0xdf, 0x0d, 0x6d, 0x3c, 0xa7, 0x51, 0x68, 0x7c, 0xf4, 0xda, 0xc6, 0xe1,
-	0x66, 0xce, 0xdd, 0x88, 0x74, 0xb7, 0x7c, 0xa5, 0x3b, 0xa5, 0x9a, 0xb3, 0xf7, 0x4e, 0x77, 0xe0,
-	0x0d, 0xf1, 0xbd, 0x16, 0x11, 0xf4, 0xe5, 0xdb, 0xd7, 0x43, 0x67, 0x90, 0xec, 0xf0, 0xc8, 0x91,
-	0x72, 0x93, 0x76, 0xb2, 0x84, 0x26, 0x98, 0xe0, 0x9e, 0xbb, 0xd1, 0x13, 0x94, 0xd7, 0x46, 0xe5,
-	0x76, 0xff, 0x19, 0x3d, 0x02, 0x53, 0x5b, 0x5d, 0x2f, 0x26, 0x42, 0x4d, 0x3d, 0xa9, 0x6e, 0xd3,
-	0x5e, 0xca, 0xd7, 0xa6, 0x3d, 0x58, 0x05, 0xe5, 0x98, 0xf8, 0x11, 0xd5, 0x8c, 0xea, 0x01, 0x7d,
-	0xd0, 0x0d, 0xcb, 0x59, 0xd2, 0x0d, 0x7b, 0x02, 0xa6, 0xc3, 0x0c, 0x27, 0xd7, 0x5d, 0xfb, 0x3f,
-	0x7f, 0xe7, 0x33, 0x08, 0x3b, 0x8f, 0x5f, 0xfb, 0x54, 0x06, 0x65, 0xc9, 0x0e, 0x29, 0x18, 0x57,
-	0xe1, 0x80, 0x0b, 0xb9, 0xea, 0xc1, 0xe4, 0x99, 0x8b, 0xc3, 0x01, 0xca, 0x17, 0xaa, 0x7d, 0xfc,
-	0xf1, 0xe7, 0xdb, 0x08, 0x84, 0x33, 0xb8, 0x1f, 0x6b, 0x95, 0x35, 0xf8, 0xd9, 0x00, 0xd3, 0xb9,
-	0xdb, 0x0f, 0xef, 0x0e, 0xb2, 0x0d, 0x09, 0xa2, 0xb9, 0x72, 0x1d, 0xa8, 0xb6, 0xb0, 0x2c, 0x2d,
-	0xdc, 0x84, 0x0b, 0xda, 0xc2, 0x7e, 0xbb, 0xff, 0xb7, 0xb2, 0x23, 0xf3, 0x86, 0x8f, 0x92, 0x20,
-	0x1f, 0xc3, 0x1e, 0x98, 0x48, 0x19, 0xe0, 0xad, 0x7f, 0x0a, 0xa4, 0x36, 0x6e, 0x5f, 0x81, 0xd2,
-	0x0e, 0x16, 0xa5, 0x03, 0x13, 0xd6, 0x2e, 0x9a, 0x90, 0x3a, 0xc0, 0x47, 0x24, 0x6a, 0x1d, 0xc3,
-	0x18, 0x54, 0xd2, 0xaa, 0x75, 0xdf, 0x2f, 0x52, 0x1f, 0x4c, 0x62, 0x91, 0x7a, 0x41, 0x96, 0x90,
-	0x29, 0xd5, 0xab, 0x10, 0x0e, 0xaa, 0xc3, 0xaf, 0x06, 0xa8, 0x64, 0xae, 0x53, 0x91, 0xf0, 0x60,
-	0x00, 0x8a, 0x84, 0x0b, 0xee, 0x24, 0x7a, 0x28, 0x85, 0x31, 0x5c, 0xbd, 0x10, 0x8e, 0x35, 0x6c,
-	0x67, 0xef, 0x40, 0xa8, 0x57, 0xc7, 0x47, 0x3c, 0x72, 0x92, 0x5f, 0x9d, 0x8e, 0xe3, 0x8d, 0xa7,
-	0x27, 0x67, 0x75, 0xe3, 0xf4, 0xac, 0x6e, 0xfc, 0x3e, 0xab, 0x1b, 0x5f, 0xce, 0xeb, 0xa5, 0xd3,
-	0xf3, 0x7a, 0xe9, 0xe7, 0x79, 0xbd, 0xf4, 0x7e, 0xc9, 0xf5, 0xc4, 0x6e, 0xe4, 0x58, 0x4d, 0xd6,
-	0xc1, 0x4e, 0xd4, 0x0d, 0xc4, 0xaa, 0x4f, 0x1c, 0xae, 0xd8, 0x0f, 0x25, 0xbf, 0xe8, 0x85, 0x94,
-	0x3b, 0xe3, 0xf2, 0x6b, 0xf1, 0xe0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x7a, 0x9f, 0xe0,
-	0xeb, 0x06, 0x00, 0x00,
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x09, 0x89, 0x60, 0x02, 0x12, 0x1a, 0xb2, 0xc2, 0x6b, 0x41, 0x60, 0xad, 0x05, 0x76,
+	0x59, 0xe1, 0xd9, 0xb0, 0x5a, 0xf5, 0xd8, 0x42, 0xa5, 0x52, 0xf5, 0x04, 0x6e, 0x45, 0xd5, 0x5e,
+	0xd0, 0x38, 0x19, 0xcc, 0x24, 0x8e, 0x6d, 0x32, 0xe3, 0x40, 0x84, 0xb8, 0xf4, 0xd2, 0x63, 0x5b,
+	0xf5, 0xda, 0x7f, 0xa3, 0xff, 0x03, 0x47, 0xa4, 0x5e, 0x7a, 0xaa, 0x2a, 0xe8, 0x1f, 0x52, 0xcd,
+	0x0f, 0x87, 0x98, 0x24, 0x0d, 0x97, 0xe8, 0xf9, 0xcd, 0xf7, 0xbe, 0xef, 0x9b, 0x37, 0xf3, 0x26,
+	0x60, 0xe1, 0x8c, 0x46, 0x21, 0x6a, 0x9c, 0x36, 0x51, 0xa7, 0x8a, 0x4e, 0x12, 0xd2, 0xee, 0x3a,
+	0x71, 0x3b, 0xe2, 0x11, 0x2c, 0x89, 0x05, 0xa7, 0x71, 0xda, 0x74, 0x3a, 0x55, 0xab, 0xec, 0x47,
+	0x7e, 0x24, 0xf3, 0x48, 0x44, 0x0a, 0x62, 0x2d, 0xfa, 0x51, 0xe4, 0x07, 0x04, 0xe1, 0x98, 0x22,
+	0x1c, 0x86, 0x11, 0xc7, 0x9c, 0x46, 0x21, 0xd3, 0xab, 0x1b, 0xb5, 0x88, 0xb5, 0x22, 0x86, 0x3c,
+	0xcc, 0x88, 0x62, 0x46, 0x9d, 0xaa, 0x47, 0x38, 0xae, 0xa2, 0x18, 0xfb, 0x34, 0x94, 0x60, 0x8d,
+	0x35, 0xfb, 0x5d, 0xc4, 0xb8, 0x8d, 0x5b, 0x29, 0x8b, 0xd5, 0xbf, 0x82, 0x93, 0x3a, 0x25, 0x61,
+	0x8d, 0xa8, 0x35, 0xbb, 0x0c, 0xe0, 0xbe, 0xe0, 0xdd, 0x93, 0x05, 0x2e, 0x39, 0x49, 0x08, 0xe3,
+	0xf6, 0x53, 0x30, 0x9f, 0xc9, 0xb2, 0x38, 0x0a, 0x19, 0x81, 0x55, 0x50, 0x54, 0xc4, 0xa6, 0xb1,
+	0x62, 0xfc, 0x55, 0xda, 0x9a, 0x77, 0xfa, 0x36, 0xe8, 0x28, 0xf0, 0xce, 0xe4, 0xe5, 0xb7, 0xe5,
+	0x9c, 0xab, 0x81, 0xf6, 0x16, 0x58, 0x94, 0x4c, 0xbb, 0x84, 0x6f, 0x6b, 0xe5, 0xc7, 0x01, 0xa6,
+	0x2d, 0xad, 0x04, 0x21, 0x98, 0x3c, 0xc6, 0xec, 0x58, 0x12, 0xce, 0xb8, 0x32, 0xb6, 0xf7, 0xc1,
+	0xd2, 0x88, 0x1a, 0xed, 0xe3, 0x5f, 0x50, 0xa8, 0x89, 0x84, 0xb6, 0x61, 0x65, 0x6c, 0x64, 0x4b,
+	0x14, 0xd0, 0xfe, 0x07, 0x2c, 0xdc, 0xa5, 0x4c, 0x1d, 0xcc, 0x81, 0x3c, 0x4e, 0xea, 0x92, 0x6a,
+	0xda, 0x15, 0xa1, 0xfd, 0x1c, 0x98, 0x83, 0x60, 0x2d, 0xfd, 0x00, 0x4c, 0xa5, 0x1d, 0xd4, 0xea,
+	0xbf, 0x0d, 0x55, 0xd7, 0x6d, 0xe8, 0x81, 0x6d, 0xac, 0x1d, 0x6c, 0x07, 0xc1, 0x5d, 0x07, 0x4f,
+	0x00, 0xb8, 0x3d, 0x4d, 0xcd, 0xba, 0xe6, 0xa8, 0xa3, 0x77, 0xc4, 0xd1, 0x3b, 0xea, 0x52, 0xe9,
+	0xa3, 0x77, 0xf6, 0xb0, 0x9f, 0xd6, 0xba, 0x7d, 0x95, 0xf6, 0x27, 0x03, 0x98, 0x83, 0x1a, 0x43,
+	0x8d, 0xe7, 0xef, 0x6d, 0x1c, 0xee, 0x66, 0xdc, 0x4d, 0x48, 0x77, 0xeb, 0x63, 0xdd, 0x29, 0xd5,
+	0x8c, 0xbd, 0x57, 0xba, 0x03, 0x07, 0x38, 0xa0, 0x75, 0xcc, 0xc9, 0xb3, 0x97, 0x2f, 0x46, 0x9e,
+	0x81, 0xc8, 0xb0, 0xc4, 0x93, 0x72, 0xd3, 0xae, 0x08, 0xa1, 0x05, 0xa6, 0x18, 0xf5, 0x77, 0xba,
+	0x9c, 0x30, 0x33, 0x2f, 0xd3, 0xbd, 0x6f, 0x9b, 0x82, 0x99, 0xbd, 0x36, 0xed, 0x60, 0xae, 0x4e,
+	0x5d, 0x54, 0x37, 0x49, 0x37, 0xe5, 0x6b, 0x92, 0x2e, 0x2c, 0x83, 0x42, 0x07, 0x07, 0x09, 0xd1,
+	0x8c, 0xea, 0x43, 0xdc, 0x3e, 0xde, 0x8d, 0x89, 0xe6, 0x93, 0x31, 0x5c, 0x02, 0xa0, 0xc1, 0xa2,
+	0xf0, 0x50, 0xc1, 0x27, 0xe5, 0xca, 0xb4, 0xc8, 0x1c, 0x88, 0x84, 0xfd, 0x39, 0x6d, 0x72, 0x66,
+	0x1b, 0xba, 0xc9, 0x0f, 0xc1, 0x6c, 0xdc, 0xe7, 0x83, 0xe9, 0x4e, 0xff, 0x9e, 0x9d, 0x93, 0x3e,
+	0x84, 0x9b, 0xc5, 0x0b, 0xe3, 0x94, 0xb1, 0x74, 0xdb, 0x94, 0xa9, 0x0c, 0xe6, 0xd2, 0x61, 0xde,
+	0x15, 0xa1, 0xc8, 0x90, 0xb3, 0x58, 0x3a, 0xcb, 0xbb, 0x22, 0x14, 0x99, 0xd0, 0x3b, 0x32, 0x0b,
+	0x2a, 0x13, 0x7a, 0x47, 0x22, 0xd3, 0xe0, 0xd4, 0x2c, 0x2a, 0x9e, 0x06, 0xa7, 0x5b, 0x6f, 0x0b,
+	0xa0, 0x20, 0x7d, 0x43, 0x02, 0x8a, 0x6a, 0x54, 0xe1, 0x72, 0xc6, 0xd7, 0xe0, 0x3b, 0x60, 0xad,
+	0x8c, 0x06, 0xa8, 0x1d, 0xdb, 0xe6, 0x9b, 0x2f, 0x3f, 0x3e, 0x4e, 0x40, 0x38, 0x87, 0x7a, 0x8f,
+	0x8c, 0x9a, 0x7c, 0xf8, 0xce, 0x00, 0xb3, 0x99, 0x59, 0x84, 0x7f, 0x0f, 0xb2, 0x8d, 0x78, 0x16,
+	0xac, 0x8d, 0xfb, 0x40, 0xb5, 0x85, 0x75, 0x69, 0xe1, 0x0f, 0xb8, 0xac, 0x2d, 0x9c, 0x34, 0x7b,
+	0x8f, 0xdc, 0xa1, 0x9c, 0x7e, 0x74, 0x2e, 0x9e, 0x95, 0x0b, 0xd8, 0x05, 0x53, 0x29, 0x03, 0xfc,
+	0xf3, 0x97, 0x02, 0xa9, 0x8d, 0xd5, 0x31, 0x28, 0xed, 0x60, 0x45, 0x3a, 0xb0, 0xa0, 0x79, 0xdb,
+	0x84, 0xd4, 0x01, 0x3a, 0xc7, 0x49, 0xfd, 0x02, 0x76, 0x40, 0x29, 0xad, 0xda, 0x0e, 0x82, 0x61,
+	0xea, 0x83, 0xef, 0x82, 0xb5, 0x3a, 0x06, 0xa5, 0xd5, 0x2d, 0xa9, 0x5e, 0x86, 0x70, 0x50, 0x1d,
+	0x7e, 0x30, 0x40, 0xa9, 0xef, 0xa2, 0x0e, 0x13, 0x1e, 0x1c, 0x47, 0x6b, 0x75, 0x0c, 0x4a, 0x0b,
+	0xff, 0x2f, 0x85, 0x11, 0xdc, 0xbc, 0x15, 0xee, 0x68, 0xd8, 0x61, 0xe3, 0x94, 0xab, 0xad, 0xa3,
+	0x73, 0x96, 0x78, 0xe2, 0x57, 0xcf, 0xea, 0xc5, 0xce, 0xa3, 0xcb, 0xeb, 0x8a, 0x71, 0x75, 0x5d,
+	0x31, 0xbe, 0x5f, 0x57, 0x8c, 0xf7, 0x37, 0x95, 0xdc, 0xd5, 0x4d, 0x25, 0xf7, 0xf5, 0xa6, 0x92,
+	0x7b, 0xbd, 0xe6, 0x53, 0x7e, 0x9c, 0x78, 0x4e, 0x2d, 0x6a, 0x21, 0x2f, 0x69, 0x87, 0x7c, 0x33,
+	0xc0, 0x1e, 0x53, 0xec, 0x67, 0x92, 0x5f, 0x4c, 0x28, 0xf3, 0x8a, 0xf2, 0xbf, 0xeb, 0xbf, 0x9f,
+	0x03, 0x00, 0xae, 0x22, 0x85, 0x18, 0x79, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1168,6 +1235,20 @@ func (m *PrivateClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.JsonValue) > 0 {
+		i -= len(m.JsonValue)
+		copy(dAtA[i:], m.JsonValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.JsonValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -1205,6 +1286,35 @@ func (m *QueryValidateJWTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Jti) > 0 {
+		i -= len(m.Jti)
+		copy(dAtA[i:], m.Jti)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Jti)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Nbf != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nbf))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Exp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Exp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Iat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Iat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Iss) > 0 {
+		i -= len(m.Iss)
+		copy(dAtA[i:], m.Iss)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Iss)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.PrivateClaims) > 0 {
 		for iNdEx := len(m.PrivateClaims) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1370,6 +1480,14 @@ func (m *PrivateClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.JsonValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1385,6 +1503,23 @@ func (m *QueryValidateJWTResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = len(m.Iss)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Iat != 0 {
+		n += 1 + sovQuery(uint64(m.Iat))
+	}
+	if m.Exp != 0 {
+		n += 1 + sovQuery(uint64(m.Exp))
+	}
+	if m.Nbf != 0 {
+		n += 1 + sovQuery(uint64(m.Nbf))
+	}
+	l = len(m.Jti)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2307,6 +2442,70 @@ func (m *PrivateClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2391,6 +2590,127 @@ func (m *QueryValidateJWTResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Iss = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iat", wireType)
+			}
+			m.Iat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exp", wireType)
+			}
+			m.Exp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nbf", wireType)
+			}
+			m.Nbf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nbf |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jti", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jti = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6,20 +6,28 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -204,9 +212,11 @@ func (m *MsgDeleteAudienceClaimResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgDeleteAudienceClaimResponse proto.InternalMessageInfo
 
 type MsgCreateAudience struct {
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	Aud   string `protobuf:"bytes,2,opt,name=aud,proto3" json:"aud,omitempty"`
-	Key   string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Admin           string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Aud             string `protobuf:"bytes,2,opt,name=aud,proto3" json:"aud,omitempty"`
+	Key             string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Issuer          string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	AuthorizedParty string `protobuf:"bytes,5,opt,name=authorized_party,json=authorizedParty,proto3" json:"authorized_party,omitempty"`
 }
 
 func (m *MsgCreateAudience) Reset()         { *m = MsgCreateAudience{} }
@@ -263,6 +273,20 @@ func (m *MsgCreateAudience) GetKey() string {
 	return ""
 }
 
+func (m *MsgCreateAudience) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgCreateAudience) GetAuthorizedParty() string {
+	if m != nil {
+		return m.AuthorizedParty
+	}
+	return ""
+}
+
 type MsgCreateAudienceResponse struct {
 	Audience *Audience `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
 }
@@ -308,10 +332,12 @@ func (m *MsgCreateAudienceResponse) GetAudience() *Audience {
 }
 
 type MsgUpdateAudience struct {
-	Admin    string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	NewAdmin string `protobuf:"bytes,2,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
-	Aud      string `protobuf:"bytes,3,opt,name=aud,proto3" json:"aud,omitempty"`
-	Key      string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Admin           string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	NewAdmin        string `protobuf:"bytes,2,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty"`
+	Aud             string `protobuf:"bytes,3,opt,name=aud,proto3" json:"aud,omitempty"`
+	Key             string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Issuer          string `protobuf:"bytes,5,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	AuthorizedParty string `protobuf:"bytes,6,opt,name=authorized_party,json=authorizedParty,proto3" json:"authorized_party,omitempty"`
 }
 
 func (m *MsgUpdateAudience) Reset()         { *m = MsgUpdateAudience{} }
@@ -375,6 +401,20 @@ func (m *MsgUpdateAudience) GetKey() string {
 	return ""
 }
 
+func (m *MsgUpdateAudience) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgUpdateAudience) GetAuthorizedParty() string {
+	if m != nil {
+		return m.AuthorizedParty
+	}
+	return ""
+}
+
 type MsgUpdateAudienceResponse struct {
 	Audience *Audience `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
 }
@@ -507,790 +547,3347 @@ func (m *MsgDeleteAudienceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteAudienceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateAudienceClaim)(nil), "xion.jwk.v1.MsgCreateAudienceClaim")
-	proto.RegisterType((*MsgCreateAudienceClaimResponse)(nil), "xion.jwk.v1.MsgCreateAudienceClaimResponse")
-	proto.RegisterType((*MsgDeleteAudienceClaim)(nil), "xion.jwk.v1.MsgDeleteAudienceClaim")
-	proto.RegisterType((*MsgDeleteAudienceClaimResponse)(nil), "xion.jwk.v1.MsgDeleteAudienceClaimResponse")
-	proto.RegisterType((*MsgCreateAudience)(nil), "xion.jwk.v1.MsgCreateAudience")
-	proto.RegisterType((*MsgCreateAudienceResponse)(nil), "xion.jwk.v1.MsgCreateAudienceResponse")
-	proto.RegisterType((*MsgUpdateAudience)(nil), "xion.jwk.v1.MsgUpdateAudience")
-	proto.RegisterType((*MsgUpdateAudienceResponse)(nil), "xion.jwk.v1.MsgUpdateAudienceResponse")
-	proto.RegisterType((*MsgDeleteAudience)(nil), "xion.jwk.v1.MsgDeleteAudience")
-	proto.RegisterType((*MsgDeleteAudienceResponse)(nil), "xion.jwk.v1.MsgDeleteAudienceResponse")
+type MsgAddAudienceKey struct {
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Aud   string `protobuf:"bytes,2,opt,name=aud,proto3" json:"aud,omitempty"`
+	// key is a single JWK with a kid, added to the key set of the audience
+	Key       string     `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	NotBefore *time.Time `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
+	NotAfter  *time.Time `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after,omitempty"`
 }
 
-func init() { proto.RegisterFile("xion/jwk/v1/tx.proto", fileDescriptor_cb37d2745ede75df) }
-
-var fileDescriptor_cb37d2745ede75df = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x8f, 0x9a, 0x40,
-	0x18, 0xc6, 0xa5, 0xf4, 0x8f, 0x8e, 0x8d, 0x69, 0xd1, 0x36, 0x8a, 0xc9, 0xc4, 0xd0, 0xc4, 0x98,
-	0x34, 0x85, 0x68, 0x8f, 0xbd, 0xd4, 0xda, 0x43, 0x7b, 0xb0, 0x07, 0x92, 0x26, 0xa6, 0x17, 0x33,
-	0xc8, 0x04, 0x10, 0x05, 0xe2, 0x80, 0xe8, 0xb7, 0xe8, 0xc7, 0xda, 0xa3, 0xc7, 0x3d, 0x6e, 0x34,
-	0xfb, 0x3d, 0x36, 0x0e, 0x4a, 0x80, 0x41, 0xd6, 0x64, 0xf7, 0x06, 0xef, 0xfb, 0xce, 0xf3, 0xfc,
-	0x66, 0xe6, 0xc9, 0x80, 0xc6, 0xc6, 0x72, 0x1d, 0x65, 0x1e, 0xda, 0xca, 0xba, 0xaf, 0xf8, 0x1b,
-	0xd9, 0x5b, 0xb9, 0xbe, 0x2b, 0x54, 0x8f, 0x55, 0x79, 0x1e, 0xda, 0xf2, 0xba, 0x2f, 0x8a, 0xc9,
-	0x11, 0x14, 0xe8, 0x16, 0x76, 0x66, 0x38, 0x1a, 0x94, 0x7e, 0x83, 0x8f, 0x63, 0x62, 0x8c, 0x56,
-	0x18, 0xf9, 0x78, 0x78, 0x6a, 0x8d, 0x16, 0xc8, 0x5a, 0x0a, 0x0d, 0xf0, 0x0a, 0xe9, 0x4b, 0xcb,
-	0x69, 0x72, 0x1d, 0xae, 0x57, 0x51, 0xa3, 0x1f, 0xa1, 0x05, 0xca, 0x28, 0xd0, 0xa7, 0x26, 0x22,
-	0x66, 0xf3, 0x45, 0x87, 0xeb, 0xbd, 0x55, 0xdf, 0xa0, 0x40, 0xff, 0x85, 0x88, 0x29, 0x75, 0x00,
-	0xcc, 0x97, 0x52, 0x31, 0xf1, 0x5c, 0x87, 0xe0, 0x93, 0xd9, 0x4f, 0xbc, 0xc0, 0xcf, 0x64, 0x96,
-	0x23, 0x15, 0x9b, 0x8d, 0xc1, 0x7b, 0x06, 0xe7, 0x82, 0xcf, 0x3b, 0xc0, 0xa3, 0x40, 0xa7, 0x16,
-	0x15, 0xf5, 0xf8, 0x79, 0xac, 0xd8, 0x78, 0xdb, 0xe4, 0xa3, 0x8a, 0x8d, 0xb7, 0xd2, 0x1f, 0xd0,
-	0x62, 0xe4, 0xce, 0x5e, 0x42, 0x9f, 0x82, 0xd2, 0x1a, 0x55, 0xae, 0x0e, 0x3e, 0xc8, 0x89, 0x1b,
-	0x90, 0xe3, 0x05, 0xf1, 0x98, 0x34, 0xa7, 0x78, 0x7f, 0x3d, 0xfd, 0x71, 0xbc, 0x36, 0xa8, 0x38,
-	0x38, 0x9c, 0x46, 0x9d, 0x08, 0xb2, 0xec, 0xe0, 0x70, 0x98, 0x64, 0xe7, 0x19, 0xf6, 0x97, 0x59,
-	0xf6, 0xb4, 0xd7, 0x53, 0xd8, 0xbf, 0x51, 0xf6, 0xf4, 0xe1, 0x5f, 0x7b, 0xb4, 0x52, 0x9b, 0xc2,
-	0xa4, 0x17, 0x9f, 0x61, 0x06, 0xf7, 0x3c, 0xe0, 0xc7, 0xc4, 0x10, 0x0c, 0x50, 0xcf, 0xcb, 0xe4,
-	0xa7, 0x14, 0x59, 0x7e, 0xda, 0xc4, 0xcf, 0x57, 0x0c, 0xc5, 0xbb, 0x37, 0x40, 0x3d, 0x2f, 0x8f,
-	0x8c, 0x51, 0xce, 0x10, 0x6b, 0x54, 0x10, 0x47, 0x61, 0x02, 0x6a, 0x99, 0x2c, 0xc2, 0x62, 0x4e,
-	0xb1, 0x5b, 0xdc, 0x4f, 0x2a, 0x67, 0x62, 0xc4, 0x28, 0xa7, 0xfb, 0xac, 0xf2, 0x85, 0x68, 0x4c,
-	0x40, 0x2d, 0x73, 0xc9, 0xb0, 0x78, 0xcb, 0xac, 0x72, 0xfe, 0x3d, 0xff, 0xf8, 0x7e, 0xb3, 0x87,
-	0xdc, 0x6e, 0x0f, 0xb9, 0xbb, 0x3d, 0xe4, 0xfe, 0x1f, 0x60, 0x69, 0x77, 0x80, 0xa5, 0xdb, 0x03,
-	0x2c, 0xfd, 0xeb, 0x1a, 0x96, 0x6f, 0x06, 0x9a, 0x3c, 0x73, 0x97, 0x8a, 0x16, 0xac, 0x1c, 0xff,
-	0xcb, 0x02, 0x69, 0x44, 0xa1, 0x4f, 0xd8, 0x86, 0x3e, 0x62, 0xfe, 0xd6, 0xc3, 0x44, 0x7b, 0x4d,
-	0xdf, 0xaf, 0xaf, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x00, 0x08, 0x88, 0xe7, 0x00, 0x05, 0x00,
-	0x00,
+func (m *MsgAddAudienceKey) Reset()         { *m = MsgAddAudienceKey{} }
+func (m *MsgAddAudienceKey) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAudienceKey) ProtoMessage()    {}
+func (*MsgAddAudienceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{10}
+}
+func (m *MsgAddAudienceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAudienceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAudienceKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAudienceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAudienceKey.Merge(m, src)
+}
+func (m *MsgAddAudienceKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAudienceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAudienceKey.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgAddAudienceKey proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateAudienceClaim(ctx context.Context, in *MsgCreateAudienceClaim, opts ...grpc.CallOption) (*MsgCreateAudienceClaimResponse, error)
-	DeleteAudienceClaim(ctx context.Context, in *MsgDeleteAudienceClaim, opts ...grpc.CallOption) (*MsgDeleteAudienceClaimResponse, error)
-	CreateAudience(ctx context.Context, in *MsgCreateAudience, opts ...grpc.CallOption) (*MsgCreateAudienceResponse, error)
-	UpdateAudience(ctx context.Context, in *MsgUpdateAudience, opts ...grpc.CallOption) (*MsgUpdateAudienceResponse, error)
-	DeleteAudience(ctx context.Context, in *MsgDeleteAudience, opts ...grpc.CallOption) (*MsgDeleteAudienceResponse, error)
+func (m *MsgAddAudienceKey) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAddAudienceKey) GetAud() string {
+	if m != nil {
+		return m.Aud
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAddAudienceKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (c *msgClient) CreateAudienceClaim(ctx context.Context, in *MsgCreateAudienceClaim, opts ...grpc.CallOption) (*MsgCreateAudienceClaimResponse, error) {
-	out := new(MsgCreateAudienceClaimResponse)
-	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/CreateAudienceClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddAudienceKey) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteAudienceClaim(ctx context.Context, in *MsgDeleteAudienceClaim, opts ...grpc.CallOption) (*MsgDeleteAudienceClaimResponse, error) {
-	out := new(MsgDeleteAudienceClaimResponse)
-	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/DeleteAudienceClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddAudienceKey) GetNotAfter() *time.Time {
+	if m != nil {
+		return m.NotAfter
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CreateAudience(ctx context.Context, in *MsgCreateAudience, opts ...grpc.CallOption) (*MsgCreateAudienceResponse, error) {
-	out := new(MsgCreateAudienceResponse)
-	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/CreateAudience", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAddAudienceKeyResponse struct {
+	Audience *Audience `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
 }
 
-func (c *msgClient) UpdateAudience(ctx context.Context, in *MsgUpdateAudience, opts ...grpc.CallOption) (*MsgUpdateAudienceResponse, error) {
-	out := new(MsgUpdateAudienceResponse)
-	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/UpdateAudience", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAddAudienceKeyResponse) Reset()         { *m = MsgAddAudienceKeyResponse{} }
+func (m *MsgAddAudienceKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAudienceKeyResponse) ProtoMessage()    {}
+func (*MsgAddAudienceKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{11}
+}
+func (m *MsgAddAudienceKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAudienceKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAudienceKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAddAudienceKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAudienceKeyResponse.Merge(m, src)
+}
+func (m *MsgAddAudienceKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAudienceKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAudienceKeyResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteAudience(ctx context.Context, in *MsgDeleteAudience, opts ...grpc.CallOption) (*MsgDeleteAudienceResponse, error) {
-	out := new(MsgDeleteAudienceResponse)
-	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/DeleteAudience", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddAudienceKeyResponse proto.InternalMessageInfo
+
+func (m *MsgAddAudienceKeyResponse) GetAudience() *Audience {
+	if m != nil {
+		return m.Audience
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateAudienceClaim(context.Context, *MsgCreateAudienceClaim) (*MsgCreateAudienceClaimResponse, error)
-	DeleteAudienceClaim(context.Context, *MsgDeleteAudienceClaim) (*MsgDeleteAudienceClaimResponse, error)
-	CreateAudience(context.Context, *MsgCreateAudience) (*MsgCreateAudienceResponse, error)
-	UpdateAudience(context.Context, *MsgUpdateAudience) (*MsgUpdateAudienceResponse, error)
-	DeleteAudience(context.Context, *MsgDeleteAudience) (*MsgDeleteAudienceResponse, error)
+type MsgRemoveAudienceKey struct {
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Aud   string `protobuf:"bytes,2,opt,name=aud,proto3" json:"aud,omitempty"`
+	Kid   string `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRemoveAudienceKey) Reset()         { *m = MsgRemoveAudienceKey{} }
+func (m *MsgRemoveAudienceKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAudienceKey) ProtoMessage()    {}
+func (*MsgRemoveAudienceKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{12}
 }
-
-func (*UnimplementedMsgServer) CreateAudienceClaim(ctx context.Context, req *MsgCreateAudienceClaim) (*MsgCreateAudienceClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAudienceClaim not implemented")
+func (m *MsgRemoveAudienceKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteAudienceClaim(ctx context.Context, req *MsgDeleteAudienceClaim) (*MsgDeleteAudienceClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAudienceClaim not implemented")
+func (m *MsgRemoveAudienceKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAudienceKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateAudience(ctx context.Context, req *MsgCreateAudience) (*MsgCreateAudienceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAudience not implemented")
+func (m *MsgRemoveAudienceKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAudienceKey.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateAudience(ctx context.Context, req *MsgUpdateAudience) (*MsgUpdateAudienceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAudience not implemented")
+func (m *MsgRemoveAudienceKey) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeleteAudience(ctx context.Context, req *MsgDeleteAudience) (*MsgDeleteAudienceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAudience not implemented")
+func (m *MsgRemoveAudienceKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAudienceKey.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgRemoveAudienceKey proto.InternalMessageInfo
 
-func _Msg_CreateAudienceClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateAudienceClaim)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateAudienceClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.jwk.v1.Msg/CreateAudienceClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateAudienceClaim(ctx, req.(*MsgCreateAudienceClaim))
+func (m *MsgRemoveAudienceKey) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeleteAudienceClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAudienceClaim)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteAudienceClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.jwk.v1.Msg/DeleteAudienceClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAudienceClaim(ctx, req.(*MsgDeleteAudienceClaim))
+func (m *MsgRemoveAudienceKey) GetAud() string {
+	if m != nil {
+		return m.Aud
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateAudience)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateAudience(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.jwk.v1.Msg/CreateAudience",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateAudience(ctx, req.(*MsgCreateAudience))
+func (m *MsgRemoveAudienceKey) GetKid() string {
+	if m != nil {
+		return m.Kid
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateAudience)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateAudience(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.jwk.v1.Msg/UpdateAudience",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateAudience(ctx, req.(*MsgUpdateAudience))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRemoveAudienceKeyResponse struct {
+	Audience *Audience `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
 }
 
-func _Msg_DeleteAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteAudience)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteAudience(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.jwk.v1.Msg/DeleteAudience",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteAudience(ctx, req.(*MsgDeleteAudience))
+func (m *MsgRemoveAudienceKeyResponse) Reset()         { *m = MsgRemoveAudienceKeyResponse{} }
+func (m *MsgRemoveAudienceKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAudienceKeyResponse) ProtoMessage()    {}
+func (*MsgRemoveAudienceKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{13}
+}
+func (m *MsgRemoveAudienceKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAudienceKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAudienceKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xion.jwk.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateAudienceClaim",
-			Handler:    _Msg_CreateAudienceClaim_Handler,
-		},
-		{
-			MethodName: "DeleteAudienceClaim",
-			Handler:    _Msg_DeleteAudienceClaim_Handler,
-		},
-		{
-			MethodName: "CreateAudience",
-			Handler:    _Msg_CreateAudience_Handler,
-		},
-		{
-			MethodName: "UpdateAudience",
-			Handler:    _Msg_UpdateAudience_Handler,
-		},
-		{
-			MethodName: "DeleteAudience",
-			Handler:    _Msg_DeleteAudience_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "xion/jwk/v1/tx.proto",
+func (m *MsgRemoveAudienceKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAudienceKeyResponse.Merge(m, src)
+}
+func (m *MsgRemoveAudienceKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAudienceKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAudienceKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateAudienceClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveAudienceKeyResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveAudienceKeyResponse) GetAudience() *Audience {
+	if m != nil {
+		return m.Audience
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateAudienceClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetAudienceKeyWindow schedules when an audience key is valid, leaving
+// both bounds unset makes it valid at all times
+type MsgSetAudienceKeyWindow struct {
+	Admin     string     `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Aud       string     `protobuf:"bytes,2,opt,name=aud,proto3" json:"aud,omitempty"`
+	Kid       string     `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	NotBefore *time.Time `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
+	NotAfter  *time.Time `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3,stdtime" json:"not_after,omitempty"`
 }
 
-func (m *MsgCreateAudienceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AudHash) > 0 {
-		i -= len(m.AudHash)
-		copy(dAtA[i:], m.AudHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AudHash)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetAudienceKeyWindow) Reset()         { *m = MsgSetAudienceKeyWindow{} }
+func (m *MsgSetAudienceKeyWindow) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAudienceKeyWindow) ProtoMessage()    {}
+func (*MsgSetAudienceKeyWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{14}
+}
+func (m *MsgSetAudienceKeyWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAudienceKeyWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAudienceKeyWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetAudienceKeyWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAudienceKeyWindow.Merge(m, src)
+}
+func (m *MsgSetAudienceKeyWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAudienceKeyWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAudienceKeyWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAudienceKeyWindow proto.InternalMessageInfo
+
+func (m *MsgSetAudienceKeyWindow) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateAudienceClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAudienceKeyWindow) GetAud() string {
+	if m != nil {
+		return m.Aud
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateAudienceClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAudienceKeyWindow) GetKid() string {
+	if m != nil {
+		return m.Kid
+	}
+	return ""
 }
 
-func (m *MsgCreateAudienceClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetAudienceKeyWindow) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
 }
 
-func (m *MsgDeleteAudienceClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAudienceKeyWindow) GetNotAfter() *time.Time {
+	if m != nil {
+		return m.NotAfter
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteAudienceClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetAudienceKeyWindowResponse struct {
+	Audience *Audience `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
 }
 
-func (m *MsgDeleteAudienceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AudHash) > 0 {
-		i -= len(m.AudHash)
-		copy(dAtA[i:], m.AudHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AudHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSetAudienceKeyWindowResponse) Reset()         { *m = MsgSetAudienceKeyWindowResponse{} }
+func (m *MsgSetAudienceKeyWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAudienceKeyWindowResponse) ProtoMessage()    {}
+func (*MsgSetAudienceKeyWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{15}
+}
+func (m *MsgSetAudienceKeyWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAudienceKeyWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAudienceKeyWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetAudienceKeyWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAudienceKeyWindowResponse.Merge(m, src)
+}
+func (m *MsgSetAudienceKeyWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAudienceKeyWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAudienceKeyWindowResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteAudienceClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAudienceKeyWindowResponse proto.InternalMessageInfo
+
+func (m *MsgSetAudienceKeyWindowResponse) GetAudience() *Audience {
+	if m != nil {
+		return m.Audience
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteAudienceClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgConsumeJWT validates a token like the ValidateJWT query and records it
+// for the signer until it expires, so it cannot authenticate another
+// transaction. Abstract accounts send it from their contract to make their
+// tokens single use.
+type MsgConsumeJWT struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Aud      string `protobuf:"bytes,2,opt,name=aud,proto3" json:"aud,omitempty"`
+	Sub      string `protobuf:"bytes,3,opt,name=sub,proto3" json:"sub,omitempty"`
+	SigBytes string `protobuf:"bytes,4,opt,name=sig_bytes,json=sigBytes,proto3" json:"sig_bytes,omitempty"`
 }
 
-func (m *MsgDeleteAudienceClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgConsumeJWT) Reset()         { *m = MsgConsumeJWT{} }
+func (m *MsgConsumeJWT) String() string { return proto.CompactTextString(m) }
+func (*MsgConsumeJWT) ProtoMessage()    {}
+func (*MsgConsumeJWT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{16}
 }
-
-func (m *MsgCreateAudience) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConsumeJWT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConsumeJWT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConsumeJWT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateAudience) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgConsumeJWT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsumeJWT.Merge(m, src)
+}
+func (m *MsgConsumeJWT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConsumeJWT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsumeJWT.DiscardUnknown(m)
 }
 
-func (m *MsgCreateAudience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_MsgConsumeJWT proto.InternalMessageInfo
+
+func (m *MsgConsumeJWT) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.Aud) > 0 {
-		i -= len(m.Aud)
-		copy(dAtA[i:], m.Aud)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgConsumeJWT) GetAud() string {
+	if m != nil {
+		return m.Aud
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgConsumeJWT) GetSub() string {
+	if m != nil {
+		return m.Sub
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateAudienceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConsumeJWT) GetSigBytes() string {
+	if m != nil {
+		return m.SigBytes
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateAudienceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgConsumeJWTResponse struct {
+	Consumed *ConsumedJWT `protobuf:"bytes,1,opt,name=consumed,proto3" json:"consumed,omitempty"`
 }
 
-func (m *MsgCreateAudienceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Audience != nil {
-		{
-			size, err := m.Audience.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgConsumeJWTResponse) Reset()         { *m = MsgConsumeJWTResponse{} }
+func (m *MsgConsumeJWTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConsumeJWTResponse) ProtoMessage()    {}
+func (*MsgConsumeJWTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{17}
+}
+func (m *MsgConsumeJWTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConsumeJWTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConsumeJWTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgConsumeJWTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsumeJWTResponse.Merge(m, src)
+}
+func (m *MsgConsumeJWTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConsumeJWTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsumeJWTResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateAudience) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgConsumeJWTResponse proto.InternalMessageInfo
+
+func (m *MsgConsumeJWTResponse) GetConsumed() *ConsumedJWT {
+	if m != nil {
+		return m.Consumed
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateAudience) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/jwk parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgUpdateAudience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Aud) > 0 {
-		i -= len(m.Aud)
-		copy(dAtA[i:], m.Aud)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewAdmin) > 0 {
-		i -= len(m.NewAdmin)
-		copy(dAtA[i:], m.NewAdmin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{18}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateAudienceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgUpdateAudienceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgUpdateAudienceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Audience != nil {
-		{
-			size, err := m.Audience.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb37d2745ede75df, []int{19}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteAudience) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateAudienceClaim)(nil), "xion.jwk.v1.MsgCreateAudienceClaim")
+	proto.RegisterType((*MsgCreateAudienceClaimResponse)(nil), "xion.jwk.v1.MsgCreateAudienceClaimResponse")
+	proto.RegisterType((*MsgDeleteAudienceClaim)(nil), "xion.jwk.v1.MsgDeleteAudienceClaim")
+	proto.RegisterType((*MsgDeleteAudienceClaimResponse)(nil), "xion.jwk.v1.MsgDeleteAudienceClaimResponse")
+	proto.RegisterType((*MsgCreateAudience)(nil), "xion.jwk.v1.MsgCreateAudience")
+	proto.RegisterType((*MsgCreateAudienceResponse)(nil), "xion.jwk.v1.MsgCreateAudienceResponse")
+	proto.RegisterType((*MsgUpdateAudience)(nil), "xion.jwk.v1.MsgUpdateAudience")
+	proto.RegisterType((*MsgUpdateAudienceResponse)(nil), "xion.jwk.v1.MsgUpdateAudienceResponse")
+	proto.RegisterType((*MsgDeleteAudience)(nil), "xion.jwk.v1.MsgDeleteAudience")
+	proto.RegisterType((*MsgDeleteAudienceResponse)(nil), "xion.jwk.v1.MsgDeleteAudienceResponse")
+	proto.RegisterType((*MsgAddAudienceKey)(nil), "xion.jwk.v1.MsgAddAudienceKey")
+	proto.RegisterType((*MsgAddAudienceKeyResponse)(nil), "xion.jwk.v1.MsgAddAudienceKeyResponse")
+	proto.RegisterType((*MsgRemoveAudienceKey)(nil), "xion.jwk.v1.MsgRemoveAudienceKey")
+	proto.RegisterType((*MsgRemoveAudienceKeyResponse)(nil), "xion.jwk.v1.MsgRemoveAudienceKeyResponse")
+	proto.RegisterType((*MsgSetAudienceKeyWindow)(nil), "xion.jwk.v1.MsgSetAudienceKeyWindow")
+	proto.RegisterType((*MsgSetAudienceKeyWindowResponse)(nil), "xion.jwk.v1.MsgSetAudienceKeyWindowResponse")
+	proto.RegisterType((*MsgConsumeJWT)(nil), "xion.jwk.v1.MsgConsumeJWT")
+	proto.RegisterType((*MsgConsumeJWTResponse)(nil), "xion.jwk.v1.MsgConsumeJWTResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "xion.jwk.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "xion.jwk.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("xion/jwk/v1/tx.proto", fileDescriptor_cb37d2745ede75df) }
+
+var fileDescriptor_cb37d2745ede75df = []byte{
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xfe, 0xf2, 0xa7, 0xf6, 0x9b, 0xfe, 0x68, 0xbd, 0x71, 0x1a, 0x7b, 0x53, 0x39, 0xe9,
+	0x12, 0x99, 0x26, 0xa5, 0x5e, 0x25, 0xa0, 0x1e, 0x2c, 0x21, 0xb0, 0xcb, 0x01, 0x01, 0x46, 0xc5,
+	0x0d, 0x2a, 0xe2, 0x62, 0x8d, 0xb3, 0x93, 0xf5, 0xc6, 0xd9, 0x1d, 0x6b, 0x67, 0x36, 0x8e, 0x39,
+	0x21, 0x8e, 0x70, 0xe9, 0xc7, 0x80, 0x5b, 0x0e, 0x08, 0xbe, 0x42, 0x8f, 0x15, 0x5c, 0x38, 0x01,
+	0x4a, 0x0e, 0xf9, 0x00, 0x7c, 0x00, 0xd0, 0xec, 0x8c, 0x37, 0xbb, 0xde, 0xb5, 0x63, 0x6a, 0x21,
+	0x2e, 0xd6, 0xce, 0xf3, 0xbc, 0xf3, 0xce, 0xfb, 0x3c, 0x33, 0x7a, 0x67, 0x0c, 0xf9, 0x53, 0x9b,
+	0xb8, 0xc6, 0x51, 0xbf, 0x6b, 0x9c, 0xec, 0x1a, 0xec, 0xb4, 0xd2, 0xf3, 0x08, 0x23, 0xea, 0x32,
+	0x47, 0x2b, 0x47, 0xfd, 0x6e, 0xe5, 0x64, 0x57, 0x5b, 0x3b, 0x20, 0xd4, 0x21, 0xd4, 0x70, 0xa8,
+	0xc5, 0x83, 0x1c, 0x6a, 0x89, 0x28, 0x2d, 0x87, 0x1c, 0xdb, 0x25, 0x46, 0xf0, 0x2b, 0xa1, 0xbc,
+	0x45, 0x2c, 0x12, 0x7c, 0x1a, 0xfc, 0x4b, 0xa2, 0x1b, 0x16, 0x21, 0xd6, 0x31, 0x36, 0x82, 0x51,
+	0xdb, 0x3f, 0x34, 0x98, 0xed, 0x60, 0xca, 0x90, 0xd3, 0x93, 0x01, 0x5a, 0xb4, 0x0a, 0xe4, 0x9b,
+	0x36, 0x76, 0x0f, 0xb0, 0xe4, 0x56, 0xa3, 0xdc, 0x51, 0x9f, 0x49, 0xb8, 0x10, 0x85, 0x7b, 0xc8,
+	0x43, 0x0e, 0x95, 0x4c, 0x51, 0xd4, 0xdb, 0x12, 0x65, 0x88, 0x81, 0xa0, 0x74, 0x06, 0x77, 0x1a,
+	0xd4, 0x7a, 0xec, 0x61, 0xc4, 0x70, 0x4d, 0x2e, 0xf3, 0xf8, 0x18, 0xd9, 0x8e, 0x9a, 0x87, 0x45,
+	0x64, 0x3a, 0xb6, 0x5b, 0x50, 0x36, 0x95, 0xfb, 0xd9, 0xa6, 0x18, 0xa8, 0x45, 0xc8, 0x20, 0xdf,
+	0x6c, 0x75, 0x10, 0xed, 0x14, 0xfe, 0xb7, 0xa9, 0xdc, 0xbf, 0xd9, 0xbc, 0x81, 0x7c, 0xf3, 0x03,
+	0x44, 0x3b, 0xd5, 0xed, 0xaf, 0x2f, 0xcf, 0x76, 0x44, 0xd8, 0x37, 0x97, 0x67, 0x3b, 0x1a, 0xaf,
+	0x24, 0x3d, 0xb7, 0xbe, 0x09, 0xa5, 0x74, 0xa6, 0x89, 0x69, 0x8f, 0xb8, 0x14, 0xcb, 0xba, 0xde,
+	0xc7, 0xc7, 0xf8, 0xdf, 0xaa, 0x2b, 0x25, 0xb7, 0xac, 0x2b, 0x85, 0x09, 0xeb, 0xfa, 0x51, 0x81,
+	0x5c, 0xa2, 0xf4, 0x31, 0x35, 0xdd, 0x86, 0x79, 0xe4, 0x9b, 0x41, 0x39, 0xd9, 0x26, 0xff, 0xe4,
+	0x48, 0x17, 0x0f, 0x0a, 0xf3, 0x02, 0xe9, 0xe2, 0x81, 0x7a, 0x07, 0x96, 0x6c, 0x4a, 0x7d, 0xec,
+	0x15, 0x16, 0x02, 0x50, 0x8e, 0xd4, 0x6d, 0xb8, 0x8d, 0x7c, 0xd6, 0x21, 0x9e, 0xfd, 0x25, 0x36,
+	0x5b, 0x3d, 0xe4, 0xb1, 0x41, 0x61, 0x31, 0x88, 0xb8, 0x75, 0x85, 0x3f, 0xe1, 0x70, 0x75, 0x2b,
+	0xae, 0x6f, 0x35, 0xd5, 0x77, 0xfd, 0x13, 0x28, 0x26, 0xc0, 0xa1, 0x2a, 0x75, 0x37, 0x70, 0x2f,
+	0xc0, 0x02, 0x09, 0xcb, 0x7b, 0xab, 0x95, 0xc8, 0x81, 0xaf, 0x84, 0x13, 0xc2, 0x30, 0xfd, 0x17,
+	0x61, 0xc4, 0x67, 0x3d, 0xf3, 0x7a, 0x23, 0xd6, 0x21, 0xeb, 0xe2, 0x7e, 0x4b, 0x30, 0xc2, 0x8e,
+	0x8c, 0x8b, 0xfb, 0xb5, 0xa8, 0x4b, 0xf3, 0x09, 0x97, 0x16, 0xd2, 0x5c, 0x5a, 0xbc, 0xd6, 0xa5,
+	0xa5, 0x7f, 0xe4, 0x52, 0xbc, 0x7e, 0xe9, 0x52, 0x1c, 0x9c, 0xc5, 0x25, 0x04, 0xb9, 0xc4, 0x81,
+	0x9a, 0xf6, 0xb4, 0x8c, 0x2b, 0x39, 0x9e, 0x4d, 0x5f, 0x87, 0x62, 0x02, 0x0c, 0x8f, 0xeb, 0x9f,
+	0x62, 0x97, 0x6a, 0xa6, 0x39, 0xa4, 0x3e, 0xc2, 0x83, 0x19, 0x8e, 0xeb, 0xbb, 0x00, 0x2e, 0x61,
+	0xad, 0x36, 0x3e, 0x24, 0x1e, 0x0e, 0x76, 0x68, 0x79, 0x4f, 0xab, 0x88, 0x66, 0x56, 0x19, 0x36,
+	0xb3, 0xca, 0xfe, 0xb0, 0x99, 0xd5, 0x17, 0x9e, 0xff, 0xbe, 0xa1, 0x34, 0xb3, 0x2e, 0x61, 0xf5,
+	0x60, 0x8a, 0xfa, 0x0e, 0xf0, 0x41, 0x0b, 0x1d, 0x32, 0xb9, 0x99, 0xd3, 0xcc, 0xcf, 0xb8, 0x84,
+	0xd5, 0xf8, 0x8c, 0x71, 0x96, 0xc4, 0xf5, 0xc9, 0x5d, 0x8c, 0x83, 0xb3, 0xec, 0xe2, 0x00, 0xf2,
+	0x0d, 0x6a, 0x35, 0xb1, 0x43, 0x4e, 0xf0, 0xab, 0xfa, 0x68, 0x87, 0x47, 0xbc, 0x6b, 0x9b, 0xd5,
+	0x37, 0xe2, 0x3a, 0x0a, 0x52, 0x47, 0x62, 0x09, 0xfd, 0x53, 0xb8, 0x9b, 0x86, 0xcf, 0xa2, 0xe6,
+	0x2f, 0x05, 0xd6, 0x1a, 0xd4, 0x7a, 0x8a, 0x59, 0x24, 0xe1, 0x33, 0xdb, 0x35, 0x49, 0xff, 0xd5,
+	0x15, 0xfd, 0xe7, 0x27, 0x63, 0x27, 0xee, 0xe8, 0xba, 0x74, 0x34, 0x4d, 0xa5, 0xbe, 0x0f, 0x1b,
+	0x63, 0xa8, 0x59, 0x7c, 0xfd, 0x56, 0x81, 0xff, 0xf3, 0x16, 0x4b, 0x5c, 0xea, 0x3b, 0xf8, 0xc3,
+	0x67, 0xfb, 0xbc, 0x6d, 0x51, 0xdb, 0x72, 0xb1, 0x27, 0xed, 0x94, 0xa3, 0x74, 0x3f, 0xa9, 0xdf,
+	0x1e, 0xfa, 0x49, 0xfd, 0x36, 0xef, 0x99, 0xd4, 0xb6, 0x5a, 0xed, 0x01, 0xc3, 0x54, 0xb6, 0xc2,
+	0x0c, 0xb5, 0xad, 0x3a, 0x1f, 0x57, 0xef, 0x71, 0xb1, 0x32, 0x1b, 0x57, 0x9b, 0x1b, 0xf6, 0xfc,
+	0x70, 0x6d, 0xbd, 0x01, 0xab, 0x31, 0x20, 0x54, 0xf6, 0x36, 0x64, 0x0e, 0x04, 0x6a, 0x4a, 0x65,
+	0x85, 0x98, 0x32, 0x39, 0xc5, 0xe4, 0x73, 0xc2, 0x48, 0xfd, 0x7b, 0x05, 0x6e, 0x85, 0x9d, 0xf1,
+	0x49, 0xf0, 0xb8, 0x50, 0x1f, 0x41, 0x56, 0x76, 0x59, 0x36, 0x10, 0x0a, 0xeb, 0x85, 0x9f, 0x7f,
+	0x78, 0x98, 0x97, 0x0f, 0x8c, 0x9a, 0x69, 0x7a, 0x98, 0xd2, 0xa7, 0xcc, 0xb3, 0x5d, 0xab, 0x79,
+	0x15, 0xaa, 0x3e, 0x82, 0x25, 0xf1, 0x3c, 0x09, 0x1c, 0x58, 0xde, 0x5b, 0x89, 0xad, 0x2f, 0x92,
+	0xd7, 0xb3, 0x2f, 0x7e, 0xdb, 0x98, 0xfb, 0xee, 0xf2, 0x6c, 0x47, 0x69, 0xca, 0xe8, 0x6a, 0x99,
+	0xab, 0xbe, 0xca, 0xc3, 0x85, 0xaf, 0xc4, 0xda, 0xb8, 0x98, 0xaa, 0x17, 0x61, 0x6d, 0x04, 0x1a,
+	0x8a, 0xdf, 0xfb, 0xe9, 0x06, 0xcc, 0x37, 0xa8, 0xa5, 0x5a, 0xb0, 0x92, 0xf6, 0xe6, 0x79, 0x3d,
+	0x56, 0x49, 0xfa, 0x13, 0x45, 0x7b, 0x30, 0x45, 0x50, 0xe8, 0xb6, 0x05, 0x2b, 0x69, 0x8f, 0x98,
+	0xc4, 0x42, 0x29, 0x41, 0xda, 0x83, 0x29, 0x82, 0xc2, 0x85, 0x3e, 0x87, 0xd7, 0x46, 0x1e, 0x25,
+	0xa5, 0xc9, 0x75, 0x6a, 0xe5, 0xc9, 0x7c, 0x34, 0xf3, 0xc8, 0x2d, 0x9f, 0xc8, 0x1c, 0xe7, 0xb5,
+	0xf2, 0x64, 0x3e, 0x9a, 0x79, 0xe4, 0x6a, 0x2c, 0x4d, 0x96, 0xac, 0x95, 0x27, 0xf3, 0xd1, 0xcc,
+	0x23, 0x77, 0x5e, 0x22, 0x73, 0x9c, 0xd7, 0xca, 0x93, 0xf9, 0x30, 0x33, 0x82, 0x5c, 0xf2, 0x22,
+	0xb8, 0x37, 0x3a, 0x39, 0x11, 0xa2, 0x6d, 0x5f, 0x1b, 0x12, 0x2e, 0x71, 0x04, 0xf9, 0xd4, 0xe6,
+	0xbc, 0x35, 0x9a, 0x22, 0x2d, 0x4a, 0x7b, 0x73, 0x9a, 0xa8, 0x70, 0xad, 0x8f, 0x01, 0x22, 0x0d,
+	0x4b, 0x4b, 0x1c, 0x89, 0x90, 0xd3, 0xf4, 0xf1, 0x5c, 0x98, 0xad, 0x09, 0x37, 0x63, 0x1d, 0xe2,
+	0x6e, 0xfa, 0x41, 0x10, 0xac, 0xb6, 0x35, 0x89, 0x1d, 0xe6, 0xd4, 0x16, 0xbf, 0xe2, 0x4d, 0xa0,
+	0xfe, 0xde, 0x8b, 0xf3, 0x92, 0xf2, 0xf2, 0xbc, 0xa4, 0xfc, 0x71, 0x5e, 0x52, 0x9e, 0x5f, 0x94,
+	0xe6, 0x5e, 0x5e, 0x94, 0xe6, 0x7e, 0xbd, 0x28, 0xcd, 0x7d, 0x51, 0xb6, 0x6c, 0xd6, 0xf1, 0xdb,
+	0x95, 0x03, 0xe2, 0x18, 0x6d, 0xdf, 0x73, 0xd9, 0xc3, 0x63, 0xd4, 0xa6, 0x46, 0xf0, 0x6f, 0xe8,
+	0x34, 0xf8, 0x3f, 0xc4, 0x06, 0x3d, 0x4c, 0xdb, 0x4b, 0xc1, 0x2d, 0xf2, 0xd6, 0xdf, 0x03, 0x00,
+	0xb9, 0xae, 0x13, 0xd0, 0xe1, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateAudienceClaim(ctx context.Context, in *MsgCreateAudienceClaim, opts ...grpc.CallOption) (*MsgCreateAudienceClaimResponse, error)
+	DeleteAudienceClaim(ctx context.Context, in *MsgDeleteAudienceClaim, opts ...grpc.CallOption) (*MsgDeleteAudienceClaimResponse, error)
+	CreateAudience(ctx context.Context, in *MsgCreateAudience, opts ...grpc.CallOption) (*MsgCreateAudienceResponse, error)
+	UpdateAudience(ctx context.Context, in *MsgUpdateAudience, opts ...grpc.CallOption) (*MsgUpdateAudienceResponse, error)
+	DeleteAudience(ctx context.Context, in *MsgDeleteAudience, opts ...grpc.CallOption) (*MsgDeleteAudienceResponse, error)
+	AddAudienceKey(ctx context.Context, in *MsgAddAudienceKey, opts ...grpc.CallOption) (*MsgAddAudienceKeyResponse, error)
+	RemoveAudienceKey(ctx context.Context, in *MsgRemoveAudienceKey, opts ...grpc.CallOption) (*MsgRemoveAudienceKeyResponse, error)
+	SetAudienceKeyWindow(ctx context.Context, in *MsgSetAudienceKeyWindow, opts ...grpc.CallOption) (*MsgSetAudienceKeyWindowResponse, error)
+	ConsumeJWT(ctx context.Context, in *MsgConsumeJWT, opts ...grpc.CallOption) (*MsgConsumeJWTResponse, error)
+	// UpdateParams updates the x/jwk params. The authority defaults to the
+	// x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateAudienceClaim(ctx context.Context, in *MsgCreateAudienceClaim, opts ...grpc.CallOption) (*MsgCreateAudienceClaimResponse, error) {
+	out := new(MsgCreateAudienceClaimResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/CreateAudienceClaim", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteAudience) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteAudienceClaim(ctx context.Context, in *MsgDeleteAudienceClaim, opts ...grpc.CallOption) (*MsgDeleteAudienceClaimResponse, error) {
+	out := new(MsgDeleteAudienceClaimResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/DeleteAudienceClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteAudience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Aud) > 0 {
-		i -= len(m.Aud)
-		copy(dAtA[i:], m.Aud)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateAudience(ctx context.Context, in *MsgCreateAudience, opts ...grpc.CallOption) (*MsgCreateAudienceResponse, error) {
+	out := new(MsgCreateAudienceResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/CreateAudience", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteAudienceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateAudience(ctx context.Context, in *MsgUpdateAudience, opts ...grpc.CallOption) (*MsgUpdateAudienceResponse, error) {
+	out := new(MsgUpdateAudienceResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/UpdateAudience", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteAudienceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeleteAudience(ctx context.Context, in *MsgDeleteAudience, opts ...grpc.CallOption) (*MsgDeleteAudienceResponse, error) {
+	out := new(MsgDeleteAudienceResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/DeleteAudience", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteAudienceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) AddAudienceKey(ctx context.Context, in *MsgAddAudienceKey, opts ...grpc.CallOption) (*MsgAddAudienceKeyResponse, error) {
+	out := new(MsgAddAudienceKeyResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/AddAudienceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) RemoveAudienceKey(ctx context.Context, in *MsgRemoveAudienceKey, opts ...grpc.CallOption) (*MsgRemoveAudienceKeyResponse, error) {
+	out := new(MsgRemoveAudienceKeyResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/RemoveAudienceKey", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateAudienceClaim) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) SetAudienceKeyWindow(ctx context.Context, in *MsgSetAudienceKeyWindow, opts ...grpc.CallOption) (*MsgSetAudienceKeyWindowResponse, error) {
+	out := new(MsgSetAudienceKeyWindowResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/SetAudienceKeyWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ConsumeJWT(ctx context.Context, in *MsgConsumeJWT, opts ...grpc.CallOption) (*MsgConsumeJWTResponse, error) {
+	out := new(MsgConsumeJWTResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/ConsumeJWT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.AudHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/xion.jwk.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateAudienceClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateAudienceClaim(context.Context, *MsgCreateAudienceClaim) (*MsgCreateAudienceClaimResponse, error)
+	DeleteAudienceClaim(context.Context, *MsgDeleteAudienceClaim) (*MsgDeleteAudienceClaimResponse, error)
+	CreateAudience(context.Context, *MsgCreateAudience) (*MsgCreateAudienceResponse, error)
+	UpdateAudience(context.Context, *MsgUpdateAudience) (*MsgUpdateAudienceResponse, error)
+	DeleteAudience(context.Context, *MsgDeleteAudience) (*MsgDeleteAudienceResponse, error)
+	AddAudienceKey(context.Context, *MsgAddAudienceKey) (*MsgAddAudienceKeyResponse, error)
+	RemoveAudienceKey(context.Context, *MsgRemoveAudienceKey) (*MsgRemoveAudienceKeyResponse, error)
+	SetAudienceKeyWindow(context.Context, *MsgSetAudienceKeyWindow) (*MsgSetAudienceKeyWindowResponse, error)
+	ConsumeJWT(context.Context, *MsgConsumeJWT) (*MsgConsumeJWTResponse, error)
+	// UpdateParams updates the x/jwk params. The authority defaults to the
+	// x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateAudienceClaim(ctx context.Context, req *MsgCreateAudienceClaim) (*MsgCreateAudienceClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAudienceClaim not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAudienceClaim(ctx context.Context, req *MsgDeleteAudienceClaim) (*MsgDeleteAudienceClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAudienceClaim not implemented")
+}
+func (*UnimplementedMsgServer) CreateAudience(ctx context.Context, req *MsgCreateAudience) (*MsgCreateAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAudience not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAudience(ctx context.Context, req *MsgUpdateAudience) (*MsgUpdateAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAudience not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAudience(ctx context.Context, req *MsgDeleteAudience) (*MsgDeleteAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAudience not implemented")
+}
+func (*UnimplementedMsgServer) AddAudienceKey(ctx context.Context, req *MsgAddAudienceKey) (*MsgAddAudienceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAudienceKey not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAudienceKey(ctx context.Context, req *MsgRemoveAudienceKey) (*MsgRemoveAudienceKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAudienceKey not implemented")
+}
+func (*UnimplementedMsgServer) SetAudienceKeyWindow(ctx context.Context, req *MsgSetAudienceKeyWindow) (*MsgSetAudienceKeyWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAudienceKeyWindow not implemented")
+}
+func (*UnimplementedMsgServer) ConsumeJWT(ctx context.Context, req *MsgConsumeJWT) (*MsgConsumeJWTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeJWT not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateAudienceClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAudienceClaim)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAudienceClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/CreateAudienceClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAudienceClaim(ctx, req.(*MsgCreateAudienceClaim))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteAudienceClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteAudienceClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAudienceClaim)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAudienceClaim(ctx, in)
 	}
-	l = len(m.AudHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/DeleteAudienceClaim",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAudienceClaim(ctx, req.(*MsgDeleteAudienceClaim))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteAudienceClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAudience)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/CreateAudience",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAudience(ctx, req.(*MsgCreateAudience))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateAudience) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAudience)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAudience(ctx, in)
 	}
-	l = len(m.Aud)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/UpdateAudience",
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAudience(ctx, req.(*MsgUpdateAudience))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateAudienceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAudience)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Audience != nil {
-		l = m.Audience.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAudience(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/DeleteAudience",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAudience(ctx, req.(*MsgDeleteAudience))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateAudience) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddAudienceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAudienceKey)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddAudienceKey(ctx, in)
 	}
-	l = len(m.NewAdmin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/AddAudienceKey",
 	}
-	l = len(m.Aud)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAudienceKey(ctx, req.(*MsgAddAudienceKey))
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAudienceKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAudienceKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAudienceKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/RemoveAudienceKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAudienceKey(ctx, req.(*MsgRemoveAudienceKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAudienceKeyWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAudienceKeyWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAudienceKeyWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/SetAudienceKeyWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAudienceKeyWindow(ctx, req.(*MsgSetAudienceKeyWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConsumeJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConsumeJWT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConsumeJWT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/ConsumeJWT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConsumeJWT(ctx, req.(*MsgConsumeJWT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.jwk.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xion.jwk.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAudienceClaim",
+			Handler:    _Msg_CreateAudienceClaim_Handler,
+		},
+		{
+			MethodName: "DeleteAudienceClaim",
+			Handler:    _Msg_DeleteAudienceClaim_Handler,
+		},
+		{
+			MethodName: "CreateAudience",
+			Handler:    _Msg_CreateAudience_Handler,
+		},
+		{
+			MethodName: "UpdateAudience",
+			Handler:    _Msg_UpdateAudience_Handler,
+		},
+		{
+			MethodName: "DeleteAudience",
+			Handler:    _Msg_DeleteAudience_Handler,
+		},
+		{
+			MethodName: "AddAudienceKey",
+			Handler:    _Msg_AddAudienceKey_Handler,
+		},
+		{
+			MethodName: "RemoveAudienceKey",
+			Handler:    _Msg_RemoveAudienceKey_Handler,
+		},
+		{
+			MethodName: "SetAudienceKeyWindow",
+			Handler:    _Msg_SetAudienceKeyWindow_Handler,
+		},
+		{
+			MethodName: "ConsumeJWT",
+			Handler:    _Msg_ConsumeJWT_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xion/jwk/v1/tx.proto",
+}
+
+func (m *MsgCreateAudienceClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAudienceClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAudienceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AudHash) > 0 {
+		i -= len(m.AudHash)
+		copy(dAtA[i:], m.AudHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AudHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAudienceClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAudienceClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAudienceClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAudienceClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAudienceClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAudienceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AudHash) > 0 {
+		i -= len(m.AudHash)
+		copy(dAtA[i:], m.AudHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AudHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAudienceClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAudienceClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAudienceClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAudience) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAudience) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAudience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthorizedParty) > 0 {
+		i -= len(m.AuthorizedParty)
+		copy(dAtA[i:], m.AuthorizedParty)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuthorizedParty)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateAudienceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateAudienceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateAudienceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Audience != nil {
+		{
+			size, err := m.Audience.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAudience) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAudience) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAudience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuthorizedParty) > 0 {
+		i -= len(m.AuthorizedParty)
+		copy(dAtA[i:], m.AuthorizedParty)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuthorizedParty)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAudienceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAudienceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAudienceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Audience != nil {
+		{
+			size, err := m.Audience.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAudience) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAudience) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAudience) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteAudienceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAudienceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAudienceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAudienceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAudienceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAudienceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NotAfter != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NotBefore != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAudienceKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAudienceKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAudienceKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Audience != nil {
+		{
+			size, err := m.Audience.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAudienceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAudienceKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAudienceKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kid) > 0 {
+		i -= len(m.Kid)
+		copy(dAtA[i:], m.Kid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAudienceKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAudienceKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAudienceKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Audience != nil {
+		{
+			size, err := m.Audience.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAudienceKeyWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAudienceKeyWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAudienceKeyWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NotAfter != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NotBefore != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Kid) > 0 {
+		i -= len(m.Kid)
+		copy(dAtA[i:], m.Kid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAudienceKeyWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAudienceKeyWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAudienceKeyWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Audience != nil {
+		{
+			size, err := m.Audience.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsumeJWT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsumeJWT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsumeJWT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigBytes) > 0 {
+		i -= len(m.SigBytes)
+		copy(dAtA[i:], m.SigBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SigBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sub) > 0 {
+		i -= len(m.Sub)
+		copy(dAtA[i:], m.Sub)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sub)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Aud) > 0 {
+		i -= len(m.Aud)
+		copy(dAtA[i:], m.Aud)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aud)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsumeJWTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsumeJWTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsumeJWTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Consumed != nil {
+		{
+			size, err := m.Consumed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateAudienceClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AudHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAudienceClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAudienceClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AudHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAudienceClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateAudience) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuthorizedParty)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAudienceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Audience != nil {
+		l = m.Audience.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAudience) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuthorizedParty)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAudienceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Audience != nil {
+		l = m.Audience.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAudience) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAudienceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAudienceKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAudienceKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Audience != nil {
+		l = m.Audience.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAudienceKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAudienceKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Audience != nil {
+		l = m.Audience.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAudienceKeyWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NotAfter)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAudienceKeyWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Audience != nil {
+		l = m.Audience.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConsumeJWT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aud)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sub)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SigBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConsumeJWTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Consumed != nil {
+		l = m.Consumed.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateAudienceClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAudienceClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAudienceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AudHash = append(m.AudHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AudHash == nil {
+				m.AudHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAudienceClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAudienceClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAudienceClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAudienceClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAudienceClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAudienceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AudHash = append(m.AudHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AudHash == nil {
+				m.AudHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAudienceClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAudienceClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAudienceClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAudience) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAudience: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAudience: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aud = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedParty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedParty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAudienceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAudienceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAudienceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Audience == nil {
+				m.Audience = &Audience{}
+			}
+			if err := m.Audience.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAudience: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAudience: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aud = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedParty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedParty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAudienceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAudienceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAudienceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Audience == nil {
+				m.Audience = &Audience{}
+			}
+			if err := m.Audience.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAudience) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAudience: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAudience: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aud = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateAudienceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Audience != nil {
-		l = m.Audience.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgDeleteAudience) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Aud)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgDeleteAudienceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAudienceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAudienceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteAudienceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateAudienceClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAudienceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1313,10 +3910,10 @@ func (m *MsgCreateAudienceClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAudienceClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAudienceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAudienceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAudienceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1349,13 +3946,113 @@ func (m *MsgCreateAudienceClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aud = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AudHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1365,24 +4062,26 @@ func (m *MsgCreateAudienceClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AudHash = append(m.AudHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AudHash == nil {
-				m.AudHash = []byte{}
+			if m.NotAfter == nil {
+				m.NotAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1406,7 +4105,7 @@ func (m *MsgCreateAudienceClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAudienceClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAudienceKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,12 +4128,48 @@ func (m *MsgCreateAudienceClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAudienceClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAudienceKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAudienceClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAudienceKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Audience == nil {
+				m.Audience = &Audience{}
+			}
+			if err := m.Audience.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1456,7 +4191,7 @@ func (m *MsgCreateAudienceClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAudienceClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAudienceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,10 +4214,10 @@ func (m *MsgDeleteAudienceClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAudienceClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAudienceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAudienceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAudienceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1519,9 +4254,9 @@ func (m *MsgDeleteAudienceClaim) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AudHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1531,25 +4266,55 @@ func (m *MsgDeleteAudienceClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AudHash = append(m.AudHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AudHash == nil {
-				m.AudHash = []byte{}
+			m.Aud = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Kid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1572,7 +4337,7 @@ func (m *MsgDeleteAudienceClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAudienceClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAudienceKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,12 +4360,48 @@ func (m *MsgDeleteAudienceClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAudienceClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAudienceKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAudienceClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAudienceKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Audience == nil {
+				m.Audience = &Audience{}
+			}
+			if err := m.Audience.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1622,7 +4423,7 @@ func (m *MsgDeleteAudienceClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAudience) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAudienceKeyWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1645,10 +4446,10 @@ func (m *MsgCreateAudience) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAudience: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAudienceKeyWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAudience: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAudienceKeyWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1717,7 +4518,7 @@ func (m *MsgCreateAudience) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1745,7 +4546,79 @@ func (m *MsgCreateAudience) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Kid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotAfter == nil {
+				m.NotAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NotAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1768,7 +4641,7 @@ func (m *MsgCreateAudience) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAudienceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAudienceKeyWindowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,10 +4664,10 @@ func (m *MsgCreateAudienceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAudienceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAudienceKeyWindowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAudienceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAudienceKeyWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1854,7 +4727,7 @@ func (m *MsgCreateAudienceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
+func (m *MsgConsumeJWT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1877,15 +4750,15 @@ func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAudience: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsumeJWT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAudience: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsumeJWT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1913,11 +4786,11 @@ func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1945,11 +4818,11 @@ func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			m.Aud = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sub", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1977,11 +4850,11 @@ func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aud = string(dAtA[iNdEx:postIndex])
+			m.Sub = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigBytes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2009,7 +4882,7 @@ func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.SigBytes = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2032,7 +4905,7 @@ func (m *MsgUpdateAudience) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAudienceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConsumeJWTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2055,15 +4928,15 @@ func (m *MsgUpdateAudienceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAudienceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsumeJWTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAudienceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsumeJWTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2090,10 +4963,10 @@ func (m *MsgUpdateAudienceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Audience == nil {
-				m.Audience = &Audience{}
+			if m.Consumed == nil {
+				m.Consumed = &ConsumedJWT{}
 			}
-			if err := m.Audience.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Consumed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2118,7 +4991,7 @@ func (m *MsgUpdateAudienceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAudience) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2141,15 +5014,15 @@ func (m *MsgDeleteAudience) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAudience: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAudience: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2177,13 +5050,13 @@ func (m *MsgDeleteAudience) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aud", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2193,23 +5066,24 @@ func (m *MsgDeleteAudience) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aud = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2232,7 +5106,7 @@ func (m *MsgDeleteAudience) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAudienceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2255,10 +5129,10 @@ func (m *MsgDeleteAudienceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAudienceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAudienceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6,8 +6,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// mint message types
+const (
+	TypeMsgUpdateParams = "update_params"
+)
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateParams) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
 // GetSignBytes implements the LegacyMsg interface.
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
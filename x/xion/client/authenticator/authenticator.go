@@ -0,0 +1,287 @@
+// Package authenticator implements a software WebAuthn authenticator, used to
+// produce registration and assertion responses without a browser.
+package authenticator
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+// Supported credential algorithms
+const (
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// authenticator data flags
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttestedData = 0x40
+)
+
+// Credential is a credential of the software authenticator, including its
+// private key
+type Credential struct {
+	ID         []byte `json:"id"`
+	Algorithm  string `json:"algorithm"`
+	PrivateKey []byte `json:"private_key"`
+	SignCount  uint32 `json:"sign_count"`
+}
+
+// Options control the flags reported by the authenticator
+type Options struct {
+	// RPID overrides the relying party id, which defaults to the rp host
+	RPID string
+	// SkipUserVerification clears the user verified flag
+	SkipUserVerification bool
+}
+
+// NewCredential generates a credential with a random id for the algorithm
+func NewCredential(algorithm string) (*Credential, error) {
+	var privateKey crypto.PrivateKey
+	var err error
+
+	switch algorithm {
+	case AlgorithmES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %s", algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &Credential{ID: id, Algorithm: algorithm, PrivateKey: der}, nil
+}
+
+// LoadCredential reads a credential saved with Save
+func LoadCredential(path string) (*Credential, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var credential Credential
+	if err := json.Unmarshal(bz, &credential); err != nil {
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+// Save writes the credential, including its private key, to a file only
+// readable by the current user
+func (c *Credential) Save(path string) error {
+	bz, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// RegistrationChallenge returns the challenge an account registers its
+// credential with, the base64url encoded account address
+func RegistrationChallenge(address string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(address))
+}
+
+// Register returns the JSON registration response of the credential, using
+// the none attestation format
+func (c *Credential) Register(rp *url.URL, challenge string, opts Options) ([]byte, error) {
+	publicKey, err := c.cosePublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	clientDataJSON, err := clientData(protocol.CreateCeremony, rp, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	// attested credential data: aaguid | credential id length | id | key
+	attestedData := make([]byte, 16)
+	attestedData = binary.BigEndian.AppendUint16(attestedData, uint16(len(c.ID)))
+	attestedData = append(attestedData, c.ID...)
+	attestedData = append(attestedData, publicKey...)
+
+	authData := c.authenticatorData(rp, opts, flagAttestedData)
+	authData = append(authData, attestedData...)
+
+	attestationObject, err := webauthncbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": authData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(protocol.CredentialCreationResponse{
+		PublicKeyCredential: c.publicKeyCredential(),
+		AttestationResponse: protocol.AuthenticatorAttestationResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{
+				ClientDataJSON: clientDataJSON,
+			},
+			AttestationObject: attestationObject,
+			Transports:        []string{string(protocol.Internal)},
+		},
+	})
+}
+
+// Assert returns the JSON assertion response of the credential, with the
+// address as user handle, and increments its sign count
+func (c *Credential) Assert(rp *url.URL, challenge, address string, opts Options) ([]byte, error) {
+	clientDataJSON, err := clientData(protocol.AssertCeremony, rp, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	c.SignCount++
+	authData := c.authenticatorData(rp, opts, 0)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signature, err := c.sign(append(authData, clientDataHash[:]...))
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(protocol.CredentialAssertionResponse{
+		PublicKeyCredential: c.publicKeyCredential(),
+		AssertionResponse: protocol.AuthenticatorAssertionResponse{
+			AuthenticatorResponse: protocol.AuthenticatorResponse{
+				ClientDataJSON: clientDataJSON,
+			},
+			AuthenticatorData: authData,
+			Signature:         signature,
+			UserHandle:        []byte(address),
+		},
+	})
+}
+
+func (c *Credential) publicKeyCredential() protocol.PublicKeyCredential {
+	id := protocol.URLEncodedBase64(c.ID)
+	encodedID, _ := id.MarshalJSON()
+
+	return protocol.PublicKeyCredential{
+		Credential: protocol.Credential{
+			// the id is the unquoted base64url encoding of the raw id
+			ID:   string(encodedID[1 : len(encodedID)-1]),
+			Type: string(protocol.PublicKeyCredentialType),
+		},
+		RawID:                   id,
+		AuthenticatorAttachment: string(protocol.Platform),
+	}
+}
+
+// authenticatorData returns the rp id hash, flags and sign count
+func (c *Credential) authenticatorData(rp *url.URL, opts Options, flags byte) []byte {
+	rpID := opts.RPID
+	if rpID == "" {
+		rpID = rp.Host
+	}
+	rpIDHash := sha256.Sum256([]byte(rpID))
+
+	flags |= flagUserPresent
+	if !opts.SkipUserVerification {
+		flags |= flagUserVerified
+	}
+
+	authData := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(authData, c.SignCount)
+}
+
+func (c *Credential) privateKey() (crypto.PrivateKey, error) {
+	return x509.ParsePKCS8PrivateKey(c.PrivateKey)
+}
+
+// cosePublicKey returns the COSE encoding of the credential public key
+func (c *Credential) cosePublicKey() ([]byte, error) {
+	privateKey, err := c.privateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	switch key := privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		return webauthncbor.Marshal(map[int]interface{}{
+			1:  int64(webauthncose.EllipticKey),
+			3:  int64(webauthncose.AlgES256),
+			-1: 1, // P-256
+			-2: key.X.FillBytes(make([]byte, 32)),
+			-3: key.Y.FillBytes(make([]byte, 32)),
+		})
+	case ed25519.PrivateKey:
+		return webauthncbor.Marshal(map[int]interface{}{
+			1:  int64(webauthncose.OctetKey),
+			3:  int64(webauthncose.AlgEdDSA),
+			-1: 6, // Ed25519
+			-2: []byte(key.Public().(ed25519.PublicKey)),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported private key %T", privateKey)
+	}
+}
+
+func (c *Credential) sign(data []byte) ([]byte, error) {
+	privateKey, err := c.privateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	switch key := privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		hash := sha256.Sum256(data)
+		return ecdsa.SignASN1(rand.Reader, key, hash[:])
+	case ed25519.PrivateKey:
+		return ed25519.Sign(key, data), nil
+	default:
+		return nil, fmt.Errorf("unsupported private key %T", privateKey)
+	}
+}
+
+func clientData(ceremony protocol.CeremonyType, rp *url.URL, challenge string) ([]byte, error) {
+	origin, err := protocol.FullyQualifiedOrigin(rp.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(protocol.CollectedClientData{
+		Type:      ceremony,
+		Challenge: challenge,
+		Origin:    origin,
+	})
+}
@@ -0,0 +1,66 @@
+package authenticator_test
+
+import (
+	"bytes"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/dvsekhvalnov/jose2go/base64url"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/require"
+
+	"github.com/burnt-labs/xion/x/xion/client/authenticator"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestSoftwareAuthenticator(t *testing.T) {
+	rpURL, err := url.Parse("https://xion.burnt.com")
+	require.NoError(t, err)
+	rp := types.NewRelyingPartyFromURL(rpURL)
+
+	address := "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq"
+	registerChallenge := base64url.Encode([]byte(address))
+	assertChallenge := base64url.Encode([]byte("sign bytes"))
+	require.Equal(t, registerChallenge, authenticator.RegistrationChallenge(address))
+
+	for _, algorithm := range []string{authenticator.AlgorithmES256, authenticator.AlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			credential, err := authenticator.NewCredential(algorithm)
+			require.NoError(t, err)
+
+			// the credential survives a save and load
+			path := filepath.Join(t.TempDir(), "webauthn", "passkey.json")
+			require.NoError(t, credential.Save(path))
+			credential, err = authenticator.LoadCredential(path)
+			require.NoError(t, err)
+
+			registerBz, err := credential.Register(rpURL, registerChallenge, authenticator.Options{})
+			require.NoError(t, err)
+			registerData, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(registerBz))
+			require.NoError(t, err)
+			verified, err := types.VerifyRegistration(rp, address, registerChallenge, registerData)
+			require.NoError(t, err)
+			require.Equal(t, credential.ID, verified.ID)
+
+			assertBz, err := credential.Assert(rpURL, assertChallenge, address, authenticator.Options{})
+			require.NoError(t, err)
+			assertData, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(assertBz))
+			require.NoError(t, err)
+			updated, err := types.ValidateAuthentication(rp, address, assertChallenge, verified, assertData, protocol.VerificationRequired)
+			require.NoError(t, err)
+			require.Equal(t, uint32(1), updated.Authenticator.SignCount)
+			require.False(t, updated.Authenticator.CloneWarning)
+
+			// assertions without user verification fail when it is required
+			assertBz, err = credential.Assert(rpURL, assertChallenge, address, authenticator.Options{SkipUserVerification: true})
+			require.NoError(t, err)
+			assertData, err = protocol.ParseCredentialRequestResponseBody(bytes.NewReader(assertBz))
+			require.NoError(t, err)
+			_, err = types.ValidateAuthentication(rp, address, assertChallenge, updated, assertData, protocol.VerificationRequired)
+			require.Error(t, err)
+			_, err = types.ValidateAuthentication(rp, address, assertChallenge, updated, assertData, protocol.VerificationPreferred)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,15 +1,31 @@
 package cli
 
 import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/burnt-labs/xion/x/xion/types"
 )
 
+const (
+	flagCodeHash = "code-hash"
+	flagCodeID   = "code-id"
+	flagSaltFile = "salt-file"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group jwk queries under a subcommand
@@ -23,8 +39,213 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdWebAuthNVerifyRegister())
 	cmd.AddCommand(CmdWebAuthNVerifyAuthenticate())
+	cmd.AddCommand(CmdWebAuthNVerifyAuthenticateStored())
+	cmd.AddCommand(CmdWebAuthNCredential())
+	cmd.AddCommand(CmdWebAuthNCredentials())
+	cmd.AddCommand(CmdAttestationPolicy())
+	cmd.AddCommand(CmdWebAuthNChallenge())
+	cmd.AddCommand(CmdAccountMigration())
+	cmd.AddCommand(CmdListAuthenticators())
+	cmd.AddCommand(CmdPredictAddress())
 
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+func CmdAccountMigration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-migration",
+		Short: "Show the progress of the latest abstract account migration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountMigration(cmd.Context(), &types.QueryAccountMigrationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListAuthenticators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-authenticators [contract-addr]",
+		Short: "List the authenticators of an abstract account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ids, err := queryAuthenticatorIDs(cmd.Context(), clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			type listedAuthenticator struct {
+				ID            uint8           `json:"id"`
+				Authenticator json.RawMessage `json:"authenticator"`
+			}
+
+			authenticators := make([]listedAuthenticator, len(ids))
+			for i, id := range ids {
+				authenticator, err := queryAuthenticator(cmd.Context(), clientCtx, args[0], id)
+				if err != nil {
+					return err
+				}
+				authenticators[i] = listedAuthenticator{ID: id, Authenticator: authenticator}
+			}
+
+			bz, err := json.Marshal(authenticators)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPredictAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predict-address [creator] [salt...] --code-hash [hex] | --code-id [uint]",
+		Short: "Predict the addresses of abstract accounts before their registration",
+		Long: `Predict the addresses of abstract accounts registered by creator with each salt.
+Salts are given as arguments or read from --salt-file, one per line.
+With --code-hash the addresses are computed offline, --code-id queries the code hash.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("creator: %w", err)
+			}
+
+			salts := args[1:]
+			saltFile, err := cmd.Flags().GetString(flagSaltFile)
+			if err != nil {
+				return err
+			}
+			if saltFile != "" {
+				fileSalts, err := readSalts(saltFile)
+				if err != nil {
+					return err
+				}
+				salts = append(salts, fileSalts...)
+			}
+			if len(salts) == 0 {
+				return errors.New("at least one salt is required")
+			}
+
+			codeHashHex, err := cmd.Flags().GetString(flagCodeHash)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := cmd.Flags().GetUint64(flagCodeID)
+			if err != nil {
+				return err
+			}
+
+			var codeHash []byte
+			switch {
+			case codeHashHex != "" && codeID != 0:
+				return fmt.Errorf("--%s and --%s are mutually exclusive", flagCodeHash, flagCodeID)
+			case codeHashHex != "":
+				codeHash, err = hex.DecodeString(codeHashHex)
+				if err != nil {
+					return fmt.Errorf("code hash: %w", err)
+				}
+			case codeID != 0:
+				codeHash, err = queryCodeHash(cmd.Context(), clientCtx, codeID)
+				if err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("one of --%s or --%s is required", flagCodeHash, flagCodeID)
+			}
+
+			type predictedAddress struct {
+				Salt    string `json:"salt"`
+				Address string `json:"address"`
+			}
+
+			addresses := make([]predictedAddress, len(salts))
+			for i, salt := range salts {
+				addr, err := types.PredictAccountAddress(codeHash, creator, []byte(salt))
+				if err != nil {
+					return fmt.Errorf("salt %q: %w", salt, err)
+				}
+				addresses[i] = predictedAddress{Salt: salt, Address: addr.String()}
+			}
+
+			bz, err := json.Marshal(addresses)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagCodeHash, "", "Hex encoded hash of the account code")
+	cmd.Flags().Uint64(flagCodeID, 0, "Id of the account code, its hash is queried")
+	cmd.Flags().String(flagSaltFile, "", "File with one salt per line")
+
+	return cmd
+}
+
+// queryCodeHash returns the hash of a stored wasm code
+func queryCodeHash(ctx context.Context, clientCtx client.Context, codeID uint64) ([]byte, error) {
+	res, err := wasmtypes.NewQueryClient(clientCtx).Code(ctx, &wasmtypes.QueryCodeRequest{CodeId: codeID})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.DataHash, nil
+}
+
+// readSalts reads one salt per non empty line of a file
+func readSalts(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var salts []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if salt := strings.TrimSpace(scanner.Text()); salt != "" {
+			salts = append(salts, salt)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return salts, nil
+}
@@ -0,0 +1,92 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/client/cli"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func (s *CLITestSuite) TestPredictAddressCmd() {
+	codeHash := sha256.Sum256([]byte("account"))
+	creator := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+
+	saltFile := filepath.Join(s.T().TempDir(), "salts")
+	s.Require().NoError(os.WriteFile(saltFile, []byte("salt-2\n\nsalt-3\n"), 0o600))
+
+	testCases := []struct {
+		name      string
+		args      []string
+		salts     []string
+		expectErr bool
+	}{
+		{
+			"salts from args and file",
+			[]string{creator.String(), "salt-1", fmt.Sprintf("--code-hash=%s", hex.EncodeToString(codeHash[:])), fmt.Sprintf("--salt-file=%s", saltFile), "--output=json"},
+			[]string{"salt-1", "salt-2", "salt-3"},
+			false,
+		},
+		{
+			"no salt",
+			[]string{creator.String(), fmt.Sprintf("--code-hash=%s", hex.EncodeToString(codeHash[:]))},
+			nil,
+			true,
+		},
+		{
+			"no code",
+			[]string{creator.String(), "salt-1"},
+			nil,
+			true,
+		},
+		{
+			"invalid code hash",
+			[]string{creator.String(), "salt-1", "--code-hash=abcd"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			var out bytes.Buffer
+			cmd := cli.CmdPredictAddress()
+			cmd.SetOutput(io.Discard)
+			cmd.SetContext(svrcmd.CreateExecuteContext(context.Background()))
+			cmd.SetArgs(tc.args)
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx.WithOutput(&out), cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var predicted []struct {
+				Salt    string `json:"salt"`
+				Address string `json:"address"`
+			}
+			s.Require().NoError(json.Unmarshal(out.Bytes(), &predicted))
+			s.Require().Len(predicted, len(tc.salts))
+			for i, salt := range tc.salts {
+				addr, err := types.PredictAccountAddress(codeHash[:], creator, []byte(salt))
+				s.Require().NoError(err)
+				s.Require().Equal(salt, predicted[i].Salt)
+				s.Require().Equal(addr.String(), predicted[i].Address)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,6 +28,16 @@ func CmdWebAuthNVerifyRegister() *cobra.Command {
 				return err
 			}
 
+			rpID, origins, err := getRelyingPartyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			binding, err := getChallengeBindingFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryWebAuthNVerifyRegisterRequest{
@@ -32,6 +45,10 @@ func CmdWebAuthNVerifyRegister() *cobra.Command {
 				Challenge: reqChallenge,
 				Rp:        reqRP,
 				Data:      reqData,
+				RpId:      rpID,
+				Origins:   origins,
+
+				ChallengeBinding: binding,
 			}
 
 			res, err := queryClient.WebAuthNVerifyRegister(cmd.Context(), params)
@@ -44,6 +61,8 @@ func CmdWebAuthNVerifyRegister() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	addRelyingPartyFlags(cmd)
+	addChallengeBindingFlags(cmd)
 
 	return cmd
 }
@@ -52,7 +71,10 @@ func CmdWebAuthNVerifyAuthenticate() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "webauthn-authenticate [addr] [challenge] [rp] [credential] [data]",
 		Short: "Test Webauthn Authentication",
-		Args:  cobra.ExactArgs(5),
+		Long: `Test Webauthn Authentication.
+Further credentials of the account can be passed with --credential, the one
+matching the assertion is used and its position reported.`,
+		Args: cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			reqAddr := args[0]
 			reqChallenge := args[1]
@@ -60,19 +82,54 @@ func CmdWebAuthNVerifyAuthenticate() *cobra.Command {
 			reqCredential := []byte(args[3])
 			reqData := []byte(args[4])
 
+			extraCredentials, err := cmd.Flags().GetStringArray(flagCredential)
+			if err != nil {
+				return err
+			}
+
+			var reqCredentials [][]byte
+			if len(extraCredentials) > 0 {
+				reqCredentials = append(reqCredentials, reqCredential)
+				for _, credential := range extraCredentials {
+					reqCredentials = append(reqCredentials, []byte(credential))
+				}
+				reqCredential = nil
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			rpID, origins, err := getRelyingPartyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			binding, err := getChallengeBindingFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			uvRequired, err := cmd.Flags().GetBool(flagUserVerificationRequired)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryWebAuthNVerifyAuthenticateRequest{
-				Addr:       reqAddr,
-				Challenge:  reqChallenge,
-				Rp:         reqRP,
-				Credential: reqCredential,
-				Data:       reqData,
+				Addr:        reqAddr,
+				Challenge:   reqChallenge,
+				Rp:          reqRP,
+				Credential:  reqCredential,
+				Credentials: reqCredentials,
+				Data:        reqData,
+				RpId:        rpID,
+				Origins:     origins,
+
+				UserVerificationRequired: uvRequired,
+				ChallengeBinding:         binding,
 			}
 
 			res, err := queryClient.WebAuthNVerifyAuthenticate(cmd.Context(), params)
@@ -85,6 +142,232 @@ func CmdWebAuthNVerifyAuthenticate() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	addRelyingPartyFlags(cmd)
+	addChallengeBindingFlags(cmd)
+	cmd.Flags().Bool(flagUserVerificationRequired, false, "Reject assertions in which the user was not verified")
+	cmd.Flags().StringArray(flagCredential, nil, "Additional credentials to select from by the assertion's credential id")
+
+	return cmd
+}
+
+func CmdWebAuthNVerifyAuthenticateStored() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webauthn-authenticate-stored [addr] [challenge] [rp] [data]",
+		Short: "Test Webauthn Authentication against a stored credential",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rpID, origins, err := getRelyingPartyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			binding, err := getChallengeBindingFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			uvRequired, err := cmd.Flags().GetBool(flagUserVerificationRequired)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryWebAuthNVerifyAuthenticateStoredRequest{
+				Addr:      args[0],
+				Challenge: args[1],
+				Rp:        args[2],
+				Data:      []byte(args[3]),
+				RpId:      rpID,
+				Origins:   origins,
+
+				UserVerificationRequired: uvRequired,
+				ChallengeBinding:         binding,
+			}
+
+			res, err := queryClient.WebAuthNVerifyAuthenticateStored(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addRelyingPartyFlags(cmd)
+	addChallengeBindingFlags(cmd)
+	cmd.Flags().Bool(flagUserVerificationRequired, false, "Reject assertions in which the user was not verified")
 
 	return cmd
 }
+
+func CmdWebAuthNCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webauthn-credential [addr] [credential_id]",
+		Short: "Show a stored Webauthn credential, identified by its base64url encoded id",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			credentialID, err := base64.RawURLEncoding.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryWebAuthNCredentialRequest{
+				Addr:         args[0],
+				CredentialId: credentialID,
+			}
+
+			res, err := queryClient.WebAuthNCredential(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWebAuthNCredentials() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webauthn-credentials [addr]",
+		Short: "List the Webauthn credentials stored for an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryWebAuthNCredentialsRequest{
+				Addr:       args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.WebAuthNCredentials(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdAttestationPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestation-policy",
+		Short: "Show the attestation policy Webauthn registrations must satisfy",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AttestationPolicy(cmd.Context(), &types.QueryAttestationPolicyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWebAuthNChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webauthn-challenge [addr] [sign_doc_hash]",
+		Short: "Derive the Webauthn challenge binding a signature to the next transaction of an account",
+		Long: `Derive the Webauthn challenge binding a signature to the next transaction of an account.
+The sign doc hash is the hex encoded SHA-256 hash of the transaction's sign bytes.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signDocHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryWebAuthNChallengeRequest{
+				Addr:        args[0],
+				SignDocHash: signDocHash,
+			}
+
+			res, err := queryClient.WebAuthNChallenge(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addChallengeBindingFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSignDocHash, "", "Require the challenge to be derived from the transaction with this hex encoded sign doc hash")
+	cmd.Flags().Uint64(flags.FlagSequence, 0, "Sequence of the transaction the challenge is derived from")
+}
+
+func getChallengeBindingFlags(cmd *cobra.Command) (*types.WebAuthnChallengeBinding, error) {
+	signDocHashHex, err := cmd.Flags().GetString(flagSignDocHash)
+	if err != nil || signDocHashHex == "" {
+		return nil, err
+	}
+
+	signDocHash, err := hex.DecodeString(signDocHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := cmd.Flags().GetUint64(flags.FlagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WebAuthnChallengeBinding{SignDocHash: signDocHash, Sequence: sequence}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"testing"
 
+	aatypes "github.com/larry0x/abstract-account/x/abstractaccount/types"
 	"github.com/stretchr/testify/suite"
 
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
@@ -29,6 +30,7 @@ func TestMigrateTestSuite(t *testing.T) {
 
 func (s *CLITestSuite) SetupSuite() {
 	s.encCfg = testutilmod.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	aatypes.RegisterInterfaces(s.encCfg.InterfaceRegistry)
 	s.kr = keyring.NewInMemory(s.encCfg.Codec)
 	s.baseCtx = client.Context{}.
 		WithKeyring(s.kr).
@@ -1,28 +1,19 @@
 package cli
 
 import (
-	"context"
-	"encoding/hex"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"os"
 	"strconv"
 
-	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	aatypes "github.com/larry0x/abstract-account/x/abstractaccount/types"
 	"github.com/spf13/cobra"
 
-	"github.com/cosmos/gogoproto/proto"
-
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
-	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/burnt-labs/xion/x/xion/types"
@@ -38,6 +29,15 @@ const (
 	flagAudience        = "aud"
 	flagToken           = "token"
 	flagSubject         = "sub"
+	flagRPID            = "rp-id"
+	flagOrigins         = "origins"
+	flagRP              = "rp"
+	flagKeyFile         = "key-file"
+	flagForce           = "force"
+
+	flagUserVerificationRequired = "user-verification-required"
+	flagCredential               = "credential"
+	flagSignDocHash              = "sign-doc-hash"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/xion transaction commands.
@@ -55,7 +55,13 @@ func NewTxCmd() *cobra.Command {
 		NewMultiSendTxCmd(),
 		NewSignCmd(),
 		NewAddAuthenticatorCmd(),
+		NewRemoveAuthenticatorCmd(),
+		NewGrantSessionCmd(),
+		NewRevokeSessionCmd(),
 		NewRegisterCmd(),
+		NewRegisterWebAuthNCredentialCmd(),
+		NewRemoveWebAuthNCredentialCmd(),
+		NewAuthenticateWebAuthNCredentialCmd(),
 	)
 
 	return txCmd
@@ -178,9 +184,11 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 func NewRegisterCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register [code-id] [keyname] --salt [string] --funds [coins,optional] --authenticator [Seckp256|Jwt,required] --authenticator-id [uint8] --aud [string] --sub [string] --token [string]",
+		Use:   "register [code-id] [keyname] --salt [string] --funds [coins,optional] --authenticator [Secp256K1|Secp256R1|Jwt|Passkey] --authenticator-id [uint8]",
 		Short: "Register an abstract account",
-		Args:  cobra.MaximumNArgs(2),
+		Long: `Register an abstract account.
+` + authenticatorTypesHelp,
+		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
 				return err
@@ -196,11 +204,6 @@ func NewRegisterCmd() *cobra.Command {
 				return err
 			}
 
-			authenticatorID, err := cmd.Flags().GetUint8(flagAuthenticatorID)
-			if err != nil {
-				return err
-			}
-
 			salt, err := cmd.Flags().GetString(flagSalt)
 			if err != nil {
 				return fmt.Errorf("salt: %s", err)
@@ -211,68 +214,32 @@ func NewRegisterCmd() *cobra.Command {
 				return fmt.Errorf("amount: %s", err)
 			}
 
-			authenticatorType, err := cmd.Flags().GetString(flagAuthenticator)
-			if err != nil {
-				return fmt.Errorf("authenticator: %s", err)
-			}
-
 			amount, err := sdk.ParseCoinsNormalized(amountStr)
 			if err != nil {
 				return fmt.Errorf("amount: %s", err)
 			}
-			queryClient := wasmtypes.NewQueryClient(clientCtx)
 
-			codeResp, err := queryClient.Code(
-				context.Background(),
-				&wasmtypes.QueryCodeRequest{
-					CodeId: codeID,
-				},
-			)
+			codeHash, err := queryCodeHash(cmd.Context(), clientCtx, codeID)
 			if err != nil {
 				return err
 			}
-			creatorAddr := clientCtx.GetFromAddress()
-			codeHash, err := hex.DecodeString(codeResp.DataHash.String())
+
+			predictedAddr, err := types.PredictAccountAddress(codeHash, clientCtx.GetFromAddress(), []byte(salt))
 			if err != nil {
 				return err
 			}
-			predictedAddr := wasmkeeper.BuildContractAddressPredictable(codeHash, creatorAddr, []byte(salt), []byte{})
 
-			signature, pubKey, err := clientCtx.Keyring.SignByAddress(clientCtx.GetFromAddress(), []byte(predictedAddr.String()))
+			authenticator, err := newAuthenticator(cmd, clientCtx, predictedAddr.String())
 			if err != nil {
-				return fmt.Errorf("error signing predicted address : %s", err)
+				return err
 			}
-			// TODO: Split authenticator types using switch,
-			var instantiateMsg string
-			switch authenticatorType {
-			case "Jwt":
-				sub, err := cmd.Flags().GetString(flagSubject)
-				if err != nil {
-					return fmt.Errorf("subject: %s", err)
-				}
 
-				aud, err := cmd.Flags().GetString(flagAudience)
-				if err != nil {
-					return fmt.Errorf("audience: %s", err)
-				}
-
-				token, err := cmd.Flags().GetString(flagToken)
-				if err != nil {
-					return fmt.Errorf("token: %s", err)
-				}
-
-				instantiateMsg, err = newInstantiateJwtMsg(token, authenticatorType, sub, aud, authenticatorID)
-				if err != nil {
-					return err
-				}
-			default:
-				instantiateMsg, err = newInstantiateMsg(authenticatorType, authenticatorID, signature, pubKey.Bytes())
-				if err != nil {
-					return err
-				}
+			instantiateMsg, err := json.Marshal(map[string]interface{}{"authenticator": authenticator})
+			if err != nil {
+				return err
 			}
 
-			msg := registerMsg(clientCtx.GetFromAddress().String(), salt, instantiateMsg, codeID, amount)
+			msg := registerMsg(clientCtx.GetFromAddress().String(), salt, string(instantiateMsg), codeID, amount)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -283,73 +250,42 @@ func NewRegisterCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addAuthenticatorFlags(cmd)
 
 	cmd.Flags().String(flagSalt, "", "Salt value used in determining account address")
-	cmd.Flags().String(flagAuthenticator, "", "Authenticator type: Seckp256K1|JWT")
 	cmd.Flags().String(flagFunds, "", "Coins to send to the account during instantiation")
-	cmd.Flags().Uint8(flagAuthenticatorID, 0, "Authenticator index locator")
-	cmd.Flags().String(flagAudience, "", "Recipient for the token")
-	cmd.Flags().String(flagToken, "", "Pre signed JWT")
-	cmd.Flags().String(flagSubject, "", "Principal for the token")
 
 	return cmd
 }
 
 func NewAddAuthenticatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add-authenticator [contract-addr] --authenticator-id [uint8]",
-		Short: "Add the signing key as an authenticator to an abstract account",
-		Args:  cobra.ExactArgs(1),
+		Use:   "add-authenticator [contract-addr] --authenticator [Secp256K1|Secp256R1|Jwt|Passkey] --authenticator-id [uint8]",
+		Short: "Add an authenticator to an abstract account",
+		Long: `Add an authenticator to an abstract account.
+` + authenticatorTypesHelp,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			authenticatorID, err := cmd.Flags().GetUint8(flagAuthenticatorID)
-			if err != nil {
-				return err
-			}
-
 			contractAddr := args[0]
 
-			signature, pubKey, err := clientCtx.Keyring.SignByAddress(clientCtx.GetFromAddress(), []byte(contractAddr))
-			if err != nil {
-				return fmt.Errorf("error signing address : %s", err)
-			}
-
-			secp256k1 := map[string]interface{}{}
-			secp256k1["id"] = authenticatorID
-			secp256k1["pubkey"] = pubKey.Bytes()
-			secp256k1["signature"] = signature
-
-			addAuthenticator := map[string]interface{}{}
-			addAuthenticator["Secp256K1"] = secp256k1
-
-			addAuthMethod := map[string]interface{}{}
-			addAuthMethod["add_authenticator"] = addAuthenticator
-
-			msg := map[string]interface{}{}
-			msg["add_auth_method"] = addAuthMethod
-
-			jsonMsg, err := json.Marshal(msg)
+			authenticator, err := newAuthenticator(cmd, clientCtx, contractAddr)
 			if err != nil {
 				return err
 			}
 
-			rawMsg := wasmtypes.RawContractMessage{}
-			err = json.Unmarshal(jsonMsg, &rawMsg)
-			if err != nil {
-				return err
+			msg := map[string]interface{}{
+				"add_auth_method": map[string]interface{}{
+					"add_authenticator": authenticator,
+				},
 			}
 
-			wasmMsg := &wasmtypes.MsgExecuteContract{
-				Sender:   contractAddr,
-				Contract: contractAddr,
-				Msg:      rawMsg,
-				Funds:    nil,
-			}
-			if err := wasmMsg.ValidateBasic(); err != nil {
+			wasmMsg, err := accountExecuteMsg(contractAddr, msg)
+			if err != nil {
 				return err
 			}
 
@@ -359,157 +295,96 @@ func NewAddAuthenticatorCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
-
-	cmd.Flags().Uint8(flagAuthenticatorID, 0, "Authenticator index locator")
+	addAuthenticatorFlags(cmd)
 
 	return cmd
 }
 
-// NewSignCmd returns a CLI command to sign a Tx with the Smart Contract Account signer
-func NewSignCmd() *cobra.Command {
+func NewRemoveAuthenticatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "sign [keyname] [path/to/tx.json]",
-		Short: "sign a transaction",
-		Long:  `Sign transaction by retrieving the Smart Contract Account signer.`,
-		Args:  cobra.ExactArgs(2),
+		Use:   "remove-authenticator [contract-addr] [authenticator-id]",
+		Short: "Remove an authenticator from an abstract account",
+		Long: `Remove an authenticator from an abstract account.
+The account's authenticators are queried first, removing its last one is refused unless --force is set.
+The check needs a node, offline transactions require --force.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
-				return err
-			}
-
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			authenticatorID, err := cmd.Flags().GetUint8(flagAuthenticatorID)
-			if err != nil {
-				return err
-			}
 
-			txBz, err := os.ReadFile(args[1])
-			if err != nil {
-				return err
-			}
+			contractAddr := args[0]
 
-			stdTx, err := clientCtx.TxConfig.TxJSONDecoder()(txBz)
+			authenticatorID, err := strconv.ParseUint(args[1], 10, 8)
 			if err != nil {
-				return err
+				return fmt.Errorf("authenticator id: %s", err)
 			}
 
-			queryClient := authtypes.NewQueryClient(clientCtx)
-
-			signerAcc, err := getSignerOfTx(queryClient, stdTx)
+			force, err := cmd.Flags().GetBool(flagForce)
 			if err != nil {
 				return err
 			}
 
-			signerData := authsigning.SignerData{
-				Address:       signerAcc.GetAddress().String(),
-				ChainID:       clientCtx.ChainID,
-				AccountNumber: signerAcc.GetAccountNumber(),
-				Sequence:      signerAcc.GetSequence(),
-				PubKey:        signerAcc.GetPubKey(), // NOTE: NilPubKey
-			}
-
-			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
-			if err != nil {
-				panic(err)
-			}
-
-			sigData := signing.SingleSignatureData{
-				SignMode:  signMode,
-				Signature: nil,
-			}
-
-			sig := signing.SignatureV2{
-				PubKey:   signerAcc.GetPubKey(),
-				Data:     &sigData,
-				Sequence: signerAcc.GetSequence(),
-			}
-
-			if err := txBuilder.SetSignatures(sig); err != nil {
-				panic(err)
-			}
-
-			signBytes, err := clientCtx.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
-			if err != nil {
-				panic(err)
-			}
-			signedBytes, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes)
-			if err != nil {
-				panic(err)
-			}
+			if !force {
+				if clientCtx.Offline {
+					return fmt.Errorf("the authenticators of %s cannot be checked offline, pass --%s to remove the authenticator anyway", contractAddr, flagForce)
+				}
 
-			sigBytes := append([]byte{authenticatorID}, signedBytes...)
-			sigData = signing.SingleSignatureData{
-				SignMode:  signMode,
-				Signature: sigBytes,
-			}
+				ids, err := queryAuthenticatorIDs(cmd.Context(), clientCtx, contractAddr)
+				if err != nil {
+					return err
+				}
 
-			sig = signing.SignatureV2{
-				PubKey:   signerAcc.GetPubKey(),
-				Data:     &sigData,
-				Sequence: signerAcc.GetSequence(),
+				if err := checkAuthenticatorRemoval(ids, uint8(authenticatorID)); err != nil {
+					return fmt.Errorf("%s, pass --%s to remove it anyway", err, flagForce)
+				}
 			}
 
-			if err := txBuilder.SetSignatures(sig); err != nil {
-				panic(err)
+			msg := map[string]interface{}{
+				"remove_auth_method": map[string]interface{}{
+					"id": uint8(authenticatorID),
+				},
 			}
 
-			bz, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			wasmMsg, err := accountExecuteMsg(contractAddr, msg)
 			if err != nil {
-				panic(err)
-			}
-			res, err := clientCtx.BroadcastTx(bz)
-			if err != nil {
-				panic(err)
+				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), wasmMsg)
 		},
+		SilenceUsage: true,
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().Uint8(flagAuthenticatorID, 0, "Authenticator index locator")
+	cmd.Flags().Bool(flagForce, false, "Remove the authenticator without checking it is not the last one")
+
 	return cmd
 }
 
-func getSignerOfTx(queryClient authtypes.QueryClient, stdTx sdk.Tx) (*aatypes.AbstractAccount, error) {
-	var signerAddr sdk.AccAddress
-	for i, msg := range stdTx.GetMsgs() {
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return nil, fmt.Errorf("msg %d has more than one signers", i)
-		}
-
-		if signerAddr != nil && !signerAddr.Equals(signers[0]) {
-			return nil, errors.New("tx has more than one signers")
-		}
-
-		signerAddr = signers[0]
-	}
-
-	res, err := queryClient.Account(context.Background(), &authtypes.QueryAccountRequest{Address: signerAddr.String()})
+// accountExecuteMsg returns a message executing msg on an abstract account,
+// sent by the account itself
+func accountExecuteMsg(contractAddr string, msg map[string]interface{}) (*wasmtypes.MsgExecuteContract, error) {
+	jsonMsg, err := json.Marshal(msg)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.Account.TypeUrl != typeURL((*aatypes.AbstractAccount)(nil)) {
-		return nil, fmt.Errorf("signer %s is not an AbstractAccount", signerAddr.String())
+	wasmMsg := &wasmtypes.MsgExecuteContract{
+		Sender:   contractAddr,
+		Contract: contractAddr,
+		Msg:      jsonMsg,
+		Funds:    nil,
 	}
-
-	acc := &aatypes.AbstractAccount{}
-	if err = proto.Unmarshal(res.Account.Value, acc); err != nil {
+	if err := wasmMsg.ValidateBasic(); err != nil {
 		return nil, err
 	}
 
-	return acc, nil
-}
-
-func typeURL(x proto.Message) string {
-	return "/" + proto.MessageName(x)
+	return wasmMsg, nil
 }
 
+// NewSignCmd returns a CLI command to sign a Tx with the Smart Contract Account signer
 func registerMsg(sender, salt, instantiateMsg string, codeID uint64, amount sdk.Coins) *aatypes.MsgRegisterAccount {
 	msg := &aatypes.MsgRegisterAccount{
 		Sender: sender,
@@ -520,60 +395,3 @@ func registerMsg(sender, salt, instantiateMsg string, codeID uint64, amount sdk.
 	}
 	return msg
 }
-
-func newInstantiateMsg(authenticatorType string, authenticatorID uint8, signature, pubKey []byte) (string, error) {
-	instantiateMsg := map[string]interface{}{}
-	authenticatorDetails := map[string]interface{}{}
-	authenticator := map[string]interface{}{}
-
-	authenticatorDetails["id"] = authenticatorID
-	authenticatorDetails["pubkey"] = pubKey
-	authenticatorDetails["signature"] = signature
-	authenticator[authenticatorType] = authenticatorDetails
-
-	instantiateMsg["authenticator"] = authenticator
-	instantiateMsgStr, err := json.Marshal(instantiateMsg)
-	if err != nil {
-		return "", fmt.Errorf("error signing contract msg : %s", err)
-	}
-	return string(instantiateMsgStr), nil
-}
-
-func newInstantiateJwtMsg(token, authenticatorType, sub, aud string, authenticatorID uint8) (string, error) {
-	instantiateMsg := map[string]interface{}{}
-	authenticatorDetails := map[string]interface{}{}
-	authenticator := map[string]interface{}{}
-
-	authenticatorDetails["sub"] = sub
-	authenticatorDetails["aud"] = aud
-	authenticatorDetails["id"] = authenticatorID
-	authenticator[authenticatorType] = authenticatorDetails
-
-	instantiateMsg["authenticator"] = authenticator
-	authenticatorDetails["token"] = []byte(token)
-	instantiateMsgStr, err := json.Marshal(instantiateMsg)
-	if err != nil {
-		return "", err
-	}
-
-	return string(instantiateMsgStr), nil
-
-	/*
-		auds := jwt.ClaimStrings{aud}
-		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
-			"iss":              aud,
-			"sub":              sub,
-			"aud":              auds,
-			"exp":              inFive.Unix(),
-			"nbf":              fiveAgo.Unix(),
-			"iat":              fiveAgo.Unix(),
-			"transaction_hash": signature,
-		})
-		t.Logf("jwt claims: %v", token)
-
-		// sign the JWT with the predefined key
-		output, err := token.SignedString(privateKey)
-		require.NoError(t, err)
-		t.Logf("signed token: %s", output)
-	*/
-}
@@ -0,0 +1,321 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/burnt-labs/xion/x/xion/client/authenticator"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// account contract authenticator types
+const (
+	authenticatorSecp256K1 = "Secp256K1"
+	authenticatorSecp256R1 = "Secp256R1"
+	authenticatorJwt       = "Jwt"
+	authenticatorPasskey   = "Passkey"
+)
+
+const authenticatorTypesHelp = `The authenticator proves control over the account address:
+Secp256K1 signs it with the --from key.
+Secp256R1 signs it with the P-256 key of --key-file, PEM or the hex encoded private key.
+Jwt uses the pre signed --token for --aud and --sub.
+Passkey uses the WebAuthn registration response in the --credential file, created for --rp with the base64url encoded account address as challenge. It is verified before the transaction is built.`
+
+func addAuthenticatorFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagAuthenticator, authenticatorSecp256K1, "Authenticator type: Secp256K1|Secp256R1|Jwt|Passkey")
+	cmd.Flags().Uint8(flagAuthenticatorID, 0, "Authenticator index locator")
+	cmd.Flags().String(flagAudience, "", "Recipient for the token")
+	cmd.Flags().String(flagToken, "", "Pre signed JWT")
+	cmd.Flags().String(flagSubject, "", "Principal for the token")
+	cmd.Flags().String(flagRP, "", "Relying party url the passkey was registered with")
+	cmd.Flags().String(flagCredential, "", "Path to the WebAuthn registration response of the passkey")
+	cmd.Flags().String(flagKeyFile, "", "Path to the Secp256R1 private key")
+}
+
+// newAuthenticator builds the authenticator described by the command flags,
+// with its proof of control over the account address, in the form the
+// account contract expects
+func newAuthenticator(cmd *cobra.Command, clientCtx client.Context, address string) (map[string]interface{}, error) {
+	authenticatorType, err := cmd.Flags().GetString(flagAuthenticator)
+	if err != nil {
+		return nil, fmt.Errorf("authenticator: %s", err)
+	}
+
+	authenticatorID, err := cmd.Flags().GetUint8(flagAuthenticatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var details map[string]interface{}
+	switch authenticatorType {
+	case authenticatorJwt:
+		sub, err := cmd.Flags().GetString(flagSubject)
+		if err != nil {
+			return nil, fmt.Errorf("subject: %s", err)
+		}
+
+		aud, err := cmd.Flags().GetString(flagAudience)
+		if err != nil {
+			return nil, fmt.Errorf("audience: %s", err)
+		}
+
+		token, err := cmd.Flags().GetString(flagToken)
+		if err != nil {
+			return nil, fmt.Errorf("token: %s", err)
+		}
+
+		details = map[string]interface{}{
+			"id":    authenticatorID,
+			"sub":   sub,
+			"aud":   aud,
+			"token": []byte(token),
+		}
+	case authenticatorPasskey:
+		rp, err := cmd.Flags().GetString(flagRP)
+		if err != nil {
+			return nil, fmt.Errorf("rp: %s", err)
+		}
+
+		credentialFile, err := cmd.Flags().GetString(flagCredential)
+		if err != nil {
+			return nil, fmt.Errorf("credential: %s", err)
+		}
+
+		credential, err := os.ReadFile(credentialFile)
+		if err != nil {
+			return nil, fmt.Errorf("credential: %s", err)
+		}
+		credential = bytes.TrimSpace(credential)
+
+		if err := verifyPasskeyRegistration(rp, address, credential); err != nil {
+			return nil, err
+		}
+
+		details = passkeyAuthenticator(rp, credential, authenticatorID)
+	case authenticatorSecp256R1:
+		keyFile, err := cmd.Flags().GetString(flagKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("key file: %s", err)
+		}
+
+		key, err := loadSecp256R1Key(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("key file: %s", err)
+		}
+
+		signature, pubKey, err := signSecp256R1(key, []byte(address))
+		if err != nil {
+			return nil, fmt.Errorf("error signing address : %s", err)
+		}
+
+		details = map[string]interface{}{
+			"id":        authenticatorID,
+			"pubkey":    pubKey,
+			"signature": signature,
+		}
+	case authenticatorSecp256K1:
+		signature, pubKey, err := clientCtx.Keyring.SignByAddress(clientCtx.GetFromAddress(), []byte(address))
+		if err != nil {
+			return nil, fmt.Errorf("error signing address : %s", err)
+		}
+
+		details = map[string]interface{}{
+			"id":        authenticatorID,
+			"pubkey":    pubKey.Bytes(),
+			"signature": signature,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported authenticator type %q", authenticatorType)
+	}
+
+	return map[string]interface{}{authenticatorType: details}, nil
+}
+
+func passkeyAuthenticator(rp string, credential []byte, authenticatorID uint8) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         authenticatorID,
+		"url":        rp,
+		"credential": credential,
+	}
+}
+
+// verifyPasskeyRegistration checks a registration response the way the
+// account contract does, the challenge being the base64url encoded account
+// address
+func verifyPasskeyRegistration(rp, address string, credential []byte) error {
+	relyingParty, err := types.NewRelyingParty(rp, "", nil)
+	if err != nil {
+		return fmt.Errorf("rp: %w", err)
+	}
+
+	data, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(credential))
+	if err != nil {
+		return fmt.Errorf("error parsing registration response: %w", err)
+	}
+
+	challenge := authenticator.RegistrationChallenge(address)
+	if _, err := types.VerifyRegistration(relyingParty, address, challenge, data); err != nil {
+		return fmt.Errorf("error verifying registration response: %w", err)
+	}
+
+	return nil
+}
+
+// loadSecp256R1Key reads a P-256 private key from a PEM file, in SEC 1 or
+// PKCS #8 form, or from a file holding the hex encoded private scalar
+func loadSecp256R1Key(path string) (*ecdsa.PrivateKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var key *ecdsa.PrivateKey
+	if block, _ := pem.Decode(bz); block != nil {
+		key, err = parsePEMSecp256R1Key(block)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		scalar, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+		if err != nil {
+			return nil, fmt.Errorf("key file is neither PEM nor hex: %w", err)
+		}
+		if len(scalar) != 32 {
+			return nil, fmt.Errorf("expected a 32 byte private key, got %d bytes", len(scalar))
+		}
+
+		key = &ecdsa.PrivateKey{D: new(big.Int).SetBytes(scalar)}
+		key.Curve = elliptic.P256()
+		key.X, key.Y = key.Curve.ScalarBaseMult(scalar)
+	}
+
+	if key.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("expected a P-256 key, got %s", key.Curve.Params().Name)
+	}
+
+	return key, nil
+}
+
+func parsePEMSecp256R1Key(block *pem.Block) (*ecdsa.PrivateKey, error) {
+	if block.Type == "EC PRIVATE KEY" {
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an ECDSA key, got %T", key)
+	}
+
+	return ecdsaKey, nil
+}
+
+// signSecp256R1 signs the SHA-256 hash of msg and returns the signature as
+// the 64 byte concatenation of r and a low s, along with the compressed
+// public key
+func signSecp256R1(key *ecdsa.PrivateKey, msg []byte) (signature, pubKey []byte, err error) {
+	hash := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order := key.Curve.Params().N
+	if s.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+		s = new(big.Int).Sub(order, s)
+	}
+
+	signature = make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return signature, elliptic.MarshalCompressed(key.Curve, key.X, key.Y), nil
+}
+
+// queryAuthenticatorIDs returns the ids of the authenticators of an account
+func queryAuthenticatorIDs(ctx context.Context, clientCtx client.Context, contractAddr string) ([]uint8, error) {
+	res, err := wasmtypes.NewQueryClient(clientCtx).SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   contractAddr,
+		QueryData: []byte(`{"authenticator_i_ds":{}}`),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uint8
+	if err := json.Unmarshal(res.Data, &ids); err != nil {
+		return nil, fmt.Errorf("error decoding authenticator ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// queryAuthenticator returns the JSON description of an account authenticator
+func queryAuthenticator(ctx context.Context, clientCtx client.Context, contractAddr string, id uint8) (json.RawMessage, error) {
+	queryData, err := json.Marshal(map[string]interface{}{
+		"authenticator_by_i_d": map[string]interface{}{"id": id},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := wasmtypes.NewQueryClient(clientCtx).SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   contractAddr,
+		QueryData: queryData,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the contract returns the authenticator as binary encoded JSON
+	var authenticator []byte
+	if err := json.Unmarshal(res.Data, &authenticator); err != nil {
+		return nil, fmt.Errorf("error decoding authenticator %d: %w", id, err)
+	}
+
+	return authenticator, nil
+}
+
+// checkAuthenticatorRemoval refuses to remove an unknown authenticator or the
+// last one of an account, which would lock the account
+func checkAuthenticatorRemoval(ids []uint8, id uint8) error {
+	found := false
+	for _, existing := range ids {
+		if existing == id {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("authenticator %d not found", id)
+	}
+	if len(ids) == 1 {
+		return fmt.Errorf("authenticator %d is the last authenticator of the account", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/burnt-labs/xion/x/xion/client/authenticator"
+)
+
+func newAuthenticatorCmd(t *testing.T, flagValues map[string]string) *cobra.Command {
+	cmd := &cobra.Command{}
+	addAuthenticatorFlags(cmd)
+	for name, value := range flagValues {
+		require.NoError(t, cmd.Flags().Set(name, value))
+	}
+	return cmd
+}
+
+func TestNewPasskeyAuthenticator(t *testing.T) {
+	const (
+		rp      = "https://xion.burnt.com"
+		address = "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq"
+	)
+
+	rpURL, err := url.Parse(rp)
+	require.NoError(t, err)
+	credential, err := authenticator.NewCredential(authenticator.AlgorithmES256)
+	require.NoError(t, err)
+	response, err := credential.Register(rpURL, authenticator.RegistrationChallenge(address), authenticator.Options{})
+	require.NoError(t, err)
+
+	credentialFile := filepath.Join(t.TempDir(), "credential.json")
+	require.NoError(t, os.WriteFile(credentialFile, append(response, '\n'), 0o600))
+
+	cmd := newAuthenticatorCmd(t, map[string]string{
+		flagAuthenticator:   authenticatorPasskey,
+		flagAuthenticatorID: "1",
+		flagRP:              rp,
+		flagCredential:      credentialFile,
+	})
+	auth, err := newAuthenticator(cmd, client.Context{}, address)
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(auth)
+	require.NoError(t, err)
+	var decoded struct {
+		Passkey struct {
+			ID         uint8  `json:"id"`
+			URL        string `json:"url"`
+			Credential []byte `json:"credential"`
+		}
+	}
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, uint8(1), decoded.Passkey.ID)
+	require.Equal(t, rp, decoded.Passkey.URL)
+	require.Equal(t, response, decoded.Passkey.Credential)
+
+	// the registration must be made for the account address and rp
+	_, err = newAuthenticator(cmd, client.Context{}, "xion1other")
+	require.Error(t, err)
+	require.NoError(t, cmd.Flags().Set(flagRP, "https://other.burnt.com"))
+	_, err = newAuthenticator(cmd, client.Context{}, address)
+	require.Error(t, err)
+}
+
+func TestNewJwtAuthenticator(t *testing.T) {
+	cmd := newAuthenticatorCmd(t, map[string]string{
+		flagAuthenticator:   authenticatorJwt,
+		flagAuthenticatorID: "2",
+		flagAudience:        "project-test",
+		flagSubject:         "user-test",
+		flagToken:           "header.payload.signature",
+	})
+	auth, err := newAuthenticator(cmd, client.Context{}, "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq")
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(auth)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Jwt":{"id":2,"aud":"project-test","sub":"user-test","token":"aGVhZGVyLnBheWxvYWQuc2lnbmF0dXJl"}}`, string(bz))
+
+	cmd = newAuthenticatorCmd(t, map[string]string{flagAuthenticator: "Unknown"})
+	_, err = newAuthenticator(cmd, client.Context{}, "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq")
+	require.Error(t, err)
+}
+
+func TestCheckAuthenticatorRemoval(t *testing.T) {
+	require.NoError(t, checkAuthenticatorRemoval([]uint8{0, 1}, 1))
+	require.Error(t, checkAuthenticatorRemoval([]uint8{0, 1}, 2))
+	require.Error(t, checkAuthenticatorRemoval([]uint8{0}, 0))
+	require.Error(t, checkAuthenticatorRemoval(nil, 0))
+}
+
+func TestSecp256R1Key(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	hexFile := filepath.Join(dir, "key.hex")
+	require.NoError(t, os.WriteFile(hexFile, []byte(hex.EncodeToString(key.D.FillBytes(make([]byte, 32)))+"\n"), 0o600))
+
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	pemFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(pemFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	pkcs8File := filepath.Join(dir, "key.pkcs8")
+	require.NoError(t, os.WriteFile(pkcs8File, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}), 0o600))
+
+	msg := []byte("xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq")
+	hash := sha256.Sum256(msg)
+	for _, file := range []string{hexFile, pemFile, pkcs8File} {
+		loaded, err := loadSecp256R1Key(file)
+		require.NoError(t, err)
+		require.True(t, key.Equal(loaded), file)
+
+		signature, pubKey, err := signSecp256R1(loaded, msg)
+		require.NoError(t, err)
+		require.Len(t, signature, 64)
+		require.Equal(t, elliptic.MarshalCompressed(elliptic.P256(), key.X, key.Y), pubKey)
+
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		require.True(t, ecdsa.Verify(&key.PublicKey, hash[:], r, s))
+		require.True(t, s.Cmp(new(big.Int).Rsh(elliptic.P256().Params().N, 1)) <= 0)
+	}
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	der, err = x509.MarshalECPrivateKey(otherKey)
+	require.NoError(t, err)
+	otherFile := filepath.Join(dir, "p384.pem")
+	require.NoError(t, os.WriteFile(otherFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600))
+	_, err = loadSecp256R1Key(otherFile)
+	require.Error(t, err)
+}
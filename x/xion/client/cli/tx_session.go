@@ -0,0 +1,343 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+const sessionSpecHelp = `The session spec is a JSON file:
+{
+  "grantee": "xion1...",                  session key address
+  "expiration": "2024-01-01T00:00:00Z",   or "duration": "24h", one of them is required
+  "contracts": [                          contracts the session may execute
+    {"address": "xion1...", "max_calls": 10, "max_funds": "1000uxion"}
+  ],
+  "msg_types": ["/cosmos.gov.v1beta1.MsgVote"],   other msgs the session may send
+  "spend_limit": "1000uxion",             bank sends the session may make
+  "fee_budget": "100uxion"                fees paid by the granter for the session
+}
+Each contract needs max_calls, max_funds or both.`
+
+// sessionSpec describes the authz and feegrant grants of a session key
+type sessionSpec struct {
+	Grantee    string            `json:"grantee"`
+	Expiration *time.Time        `json:"expiration,omitempty"`
+	Duration   string            `json:"duration,omitempty"`
+	Contracts  []sessionContract `json:"contracts,omitempty"`
+	MsgTypes   []string          `json:"msg_types,omitempty"`
+	SpendLimit string            `json:"spend_limit,omitempty"`
+	FeeBudget  string            `json:"fee_budget,omitempty"`
+}
+
+// sessionContract is a contract a session key may execute, within limits
+type sessionContract struct {
+	Address  string `json:"address"`
+	MaxCalls uint64 `json:"max_calls,omitempty"`
+	MaxFunds string `json:"max_funds,omitempty"`
+}
+
+func readSessionSpec(path string) (sessionSpec, error) {
+	var spec sessionSpec
+
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return spec, err
+	}
+
+	if err := json.Unmarshal(bz, &spec); err != nil {
+		return spec, fmt.Errorf("error decoding session spec: %w", err)
+	}
+
+	return spec, nil
+}
+
+func (s sessionSpec) grantee() (sdk.AccAddress, error) {
+	grantee, err := sdk.AccAddressFromBech32(s.Grantee)
+	if err != nil {
+		return nil, fmt.Errorf("grantee: %w", err)
+	}
+	return grantee, nil
+}
+
+func (s sessionSpec) expiration(now time.Time) (time.Time, error) {
+	switch {
+	case s.Expiration != nil && s.Duration != "":
+		return time.Time{}, errors.New("expiration and duration are mutually exclusive")
+	case s.Expiration != nil:
+		return s.Expiration.UTC(), nil
+	case s.Duration != "":
+		duration, err := time.ParseDuration(s.Duration)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("duration: %w", err)
+		}
+		if duration <= 0 {
+			return time.Time{}, errors.New("duration must be positive")
+		}
+		return now.Add(duration).UTC(), nil
+	default:
+		return time.Time{}, errors.New("expiration or duration is required")
+	}
+}
+
+// authorizations returns the authz authorizations of the session, at most one
+// per msg type
+func (s sessionSpec) authorizations() ([]authz.Authorization, error) {
+	var authorizations []authz.Authorization
+
+	if len(s.Contracts) > 0 {
+		grants := make([]wasmtypes.ContractGrant, len(s.Contracts))
+		for i, contract := range s.Contracts {
+			grant, err := contract.grant()
+			if err != nil {
+				return nil, fmt.Errorf("contract %d: %w", i, err)
+			}
+			grants[i] = *grant
+		}
+		authorizations = append(authorizations, wasmtypes.NewContractExecutionAuthorization(grants...))
+	}
+
+	if s.SpendLimit != "" {
+		spendLimit, err := sdk.ParseCoinsNormalized(s.SpendLimit)
+		if err != nil {
+			return nil, fmt.Errorf("spend limit: %w", err)
+		}
+		authorizations = append(authorizations, banktypes.NewSendAuthorization(spendLimit, nil))
+	}
+
+	for _, msgType := range s.MsgTypes {
+		authorizations = append(authorizations, authz.NewGenericAuthorization(msgType))
+	}
+
+	if len(authorizations) == 0 {
+		return nil, errors.New("the session grants no contracts, msg types or spend limit")
+	}
+
+	seen := make(map[string]bool)
+	for _, authorization := range authorizations {
+		msgType := authorization.MsgTypeURL()
+		if seen[msgType] {
+			return nil, fmt.Errorf("%s is granted more than once", msgType)
+		}
+		seen[msgType] = true
+
+		if err := authorization.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("%s: %w", msgType, err)
+		}
+	}
+
+	return authorizations, nil
+}
+
+func (c sessionContract) grant() (*wasmtypes.ContractGrant, error) {
+	contract, err := sdk.AccAddressFromBech32(c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("address: %w", err)
+	}
+
+	var maxFunds sdk.Coins
+	if c.MaxFunds != "" {
+		maxFunds, err = sdk.ParseCoinsNormalized(c.MaxFunds)
+		if err != nil {
+			return nil, fmt.Errorf("max funds: %w", err)
+		}
+	}
+
+	var limit wasmtypes.ContractAuthzLimitX
+	switch {
+	case c.MaxCalls != 0 && c.MaxFunds != "":
+		limit = wasmtypes.NewCombinedLimit(c.MaxCalls, maxFunds...)
+	case c.MaxCalls != 0:
+		limit = wasmtypes.NewMaxCallsLimit(c.MaxCalls)
+	case c.MaxFunds != "":
+		limit = wasmtypes.NewMaxFundsLimit(maxFunds...)
+	default:
+		return nil, errors.New("max calls or max funds is required")
+	}
+
+	return wasmtypes.NewContractGrant(contract, limit, wasmtypes.NewAllowAllMessagesFilter())
+}
+
+// allowance returns the fee allowance of the session. It only pays for the
+// authz msgs of the grantee and, when the session is limited to contracts,
+// only for their execution.
+func (s sessionSpec) allowance(grantee sdk.AccAddress, expiration time.Time) (feegrant.FeeAllowanceI, error) {
+	feeBudget, err := sdk.ParseCoinsNormalized(s.FeeBudget)
+	if err != nil {
+		return nil, fmt.Errorf("fee budget: %w", err)
+	}
+
+	var allowance feegrant.FeeAllowanceI = &feegrant.BasicAllowance{
+		SpendLimit: feeBudget,
+		Expiration: &expiration,
+	}
+
+	if len(s.MsgTypes) == 0 && s.SpendLimit == "" {
+		contracts := make([]sdk.AccAddress, len(s.Contracts))
+		for i, contract := range s.Contracts {
+			contracts[i], err = sdk.AccAddressFromBech32(contract.Address)
+			if err != nil {
+				return nil, fmt.Errorf("contract %d: %w", i, err)
+			}
+		}
+
+		allowance, err = types.NewContractsAllowance(allowance, contracts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return types.NewAuthzAllowance(allowance, grantee)
+}
+
+// grantMsgs returns the msgs granting the session to its grantee
+func (s sessionSpec) grantMsgs(granter sdk.AccAddress, now time.Time) ([]sdk.Msg, error) {
+	grantee, err := s.grantee()
+	if err != nil {
+		return nil, err
+	}
+
+	expiration, err := s.expiration(now)
+	if err != nil {
+		return nil, err
+	}
+	if !expiration.After(now) {
+		return nil, fmt.Errorf("expiration %s is in the past", expiration)
+	}
+
+	authorizations, err := s.authorizations()
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []sdk.Msg
+	for _, authorization := range authorizations {
+		msg, err := authz.NewMsgGrant(granter, grantee, authorization, &expiration)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if s.FeeBudget != "" {
+		allowance, err := s.allowance(grantee, expiration)
+		if err != nil {
+			return nil, err
+		}
+
+		msg, err := feegrant.NewMsgGrantAllowance(allowance, granter, grantee)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// revokeMsgs returns the msgs revoking the grants made for the session
+func (s sessionSpec) revokeMsgs(granter sdk.AccAddress) ([]sdk.Msg, error) {
+	grantee, err := s.grantee()
+	if err != nil {
+		return nil, err
+	}
+
+	authorizations, err := s.authorizations()
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []sdk.Msg
+	for _, authorization := range authorizations {
+		msg := authz.NewMsgRevoke(granter, grantee, authorization.MsgTypeURL())
+		msgs = append(msgs, &msg)
+	}
+
+	if s.FeeBudget != "" {
+		msg := feegrant.NewMsgRevokeAllowance(granter, grantee)
+		msgs = append(msgs, &msg)
+	}
+
+	return msgs, nil
+}
+
+func NewGrantSessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-session [path/to/session.json]",
+		Short: "Grant a session key the authz and feegrant grants of a session spec",
+		Long: `Grant a session key the authz and feegrant grants of a session spec, in a single transaction.
+` + sessionSpecHelp,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spec, err := readSessionSpec(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgs, err := spec.grantMsgs(clientCtx.GetFromAddress(), time.Now())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRevokeSessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-session [path/to/session.json]",
+		Short: "Revoke the grants made for a session spec",
+		Long: `Revoke the authz and feegrant grants made for a session spec with grant-session, in a single transaction.
+` + sessionSpecHelp,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spec, err := readSessionSpec(args[0])
+			if err != nil {
+				return err
+			}
+
+			msgs, err := spec.revokeMsgs(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
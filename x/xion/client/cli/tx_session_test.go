@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestSessionSpec(t *testing.T) {
+	granter := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	grantee := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	contract := sdk.AccAddress(bytes.Repeat([]byte{3}, 32))
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	spec := sessionSpec{
+		Grantee:   grantee.String(),
+		Duration:  "24h",
+		Contracts: []sessionContract{{Address: contract.String(), MaxCalls: 10, MaxFunds: "100uxion"}},
+		FeeBudget: "10uxion",
+	}
+
+	msgs, err := spec.grantMsgs(granter, now)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	grant := msgs[0].(*authz.MsgGrant)
+	require.NoError(t, grant.ValidateBasic())
+	require.Equal(t, granter.String(), grant.Granter)
+	require.Equal(t, grantee.String(), grant.Grantee)
+	require.Equal(t, now.Add(24*time.Hour), *grant.Grant.Expiration)
+	authorization, err := grant.GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}), authorization.MsgTypeURL())
+
+	// a session limited to contracts only pays fees for their execution
+	allowanceMsg := msgs[1].(*feegrant.MsgGrantAllowance)
+	require.NoError(t, allowanceMsg.ValidateBasic())
+	allowance, err := allowanceMsg.GetFeeAllowanceI()
+	require.NoError(t, err)
+	authzAllowance := allowance.(*types.AuthzAllowance)
+	require.Equal(t, grantee.String(), authzAllowance.AuthzGrantee)
+	inner, err := authzAllowance.GetAllowance()
+	require.NoError(t, err)
+	contractsAllowance := inner.(*types.ContractsAllowance)
+	require.Equal(t, []string{contract.String()}, contractsAllowance.ContractAddresses)
+
+	revokeMsgs, err := spec.revokeMsgs(granter)
+	require.NoError(t, err)
+	require.Len(t, revokeMsgs, 2)
+	require.Equal(t, sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}), revokeMsgs[0].(*authz.MsgRevoke).MsgTypeUrl)
+	require.Equal(t, grantee.String(), revokeMsgs[1].(*feegrant.MsgRevokeAllowance).Grantee)
+
+	// other msgs lift the contract restriction of the fee allowance
+	spec.MsgTypes = []string{"/cosmos.gov.v1beta1.MsgVote"}
+	spec.SpendLimit = "5uxion"
+	msgs, err = spec.grantMsgs(granter, now)
+	require.NoError(t, err)
+	require.Len(t, msgs, 4)
+	authorization, err = msgs[1].(*authz.MsgGrant).GetAuthorization()
+	require.NoError(t, err)
+	require.IsType(t, &banktypes.SendAuthorization{}, authorization)
+	allowance, err = msgs[3].(*feegrant.MsgGrantAllowance).GetFeeAllowanceI()
+	require.NoError(t, err)
+	inner, err = allowance.(*types.AuthzAllowance).GetAllowance()
+	require.NoError(t, err)
+	require.IsType(t, &feegrant.BasicAllowance{}, inner)
+}
+
+func TestSessionSpecInvalid(t *testing.T) {
+	granter := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	grantee := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	contract := sdk.AccAddress(bytes.Repeat([]byte{3}, 32)).String()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+
+	for name, spec := range map[string]sessionSpec{
+		"invalid grantee":       {Grantee: "xion1invalid", Duration: "1h", MsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+		"no expiry":             {Grantee: grantee, MsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+		"expired":               {Grantee: grantee, Expiration: &past, MsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+		"both expiries":         {Grantee: grantee, Expiration: &now, Duration: "1h", MsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+		"no grants":             {Grantee: grantee, Duration: "1h", FeeBudget: "10uxion"},
+		"unlimited contract":    {Grantee: grantee, Duration: "1h", Contracts: []sessionContract{{Address: contract}}},
+		"duplicate msg type":    {Grantee: grantee, Duration: "1h", SpendLimit: "5uxion", MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		"invalid fee budget":    {Grantee: grantee, Duration: "1h", MsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote"}, FeeBudget: "uxion"},
+		"invalid spend limit":   {Grantee: grantee, Duration: "1h", SpendLimit: "0uxion"},
+		"invalid contract":      {Grantee: grantee, Duration: "1h", Contracts: []sessionContract{{Address: "xion1invalid", MaxCalls: 1}}},
+		"non positive duration": {Grantee: grantee, Duration: "-1h", MsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote"}},
+	} {
+		_, err := spec.grantMsgs(granter, now)
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,390 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	aatypes "github.com/larry0x/abstract-account/x/abstractaccount/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	flagBatch          = "batch"
+	flagSignatureFile  = "signature-file"
+	flagPrintSignBytes = "print-sign-bytes"
+)
+
+// authenticatorSignature is a signature made by an authenticator of an
+// abstract account, as read from a signature file
+type authenticatorSignature struct {
+	AuthenticatorID uint8  `json:"authenticator_id"`
+	Signature       []byte `json:"signature"`
+}
+
+func NewSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [keyname] [path/to/tx.json]",
+		Short: "sign a transaction",
+		Long: `Sign transaction by retrieving the Smart Contract Account signer.
+
+With --batch the file holds one transaction per line, all from the same account, signed with increasing sequences.
+With --offline the account is not queried, --account-number, --sequence and --chain-id must be set.
+Offline or with --generate-only the signed transactions are printed, or written to --output-document, instead of being broadcast.
+
+Signatures of authenticators outside the keyring are read from --signature-file, a JSON array with one
+{"authenticator_id": uint8, "signature": base64} entry per transaction. --print-sign-bytes prints the base64
+encoded bytes each of these authenticators has to sign.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authenticatorID, err := cmd.Flags().GetUint8(flagAuthenticatorID)
+			if err != nil {
+				return err
+			}
+
+			batch, err := cmd.Flags().GetBool(flagBatch)
+			if err != nil {
+				return err
+			}
+
+			signatureFile, err := cmd.Flags().GetString(flagSignatureFile)
+			if err != nil {
+				return err
+			}
+
+			printSignBytes, err := cmd.Flags().GetBool(flagPrintSignBytes)
+			if err != nil {
+				return err
+			}
+
+			txs, err := readTxs(clientCtx, args[1], batch)
+			if err != nil {
+				return err
+			}
+
+			signerAddr, err := getSignerOfTxs(txs)
+			if err != nil {
+				return err
+			}
+
+			accountNumber, sequence, err := getSignerAccount(cmd, clientCtx, signerAddr)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.ChainID == "" {
+				return errors.New("chain id is required to sign a transaction")
+			}
+
+			var signatures []authenticatorSignature
+			if signatureFile != "" && !printSignBytes {
+				signatures, err = readAuthenticatorSignatures(signatureFile, len(txs))
+				if err != nil {
+					return err
+				}
+			}
+
+			signBytes := make([][]byte, len(txs))
+			signedTxs := make([]sdk.Tx, len(txs))
+			for i, stdTx := range txs {
+				signerData := authsigning.SignerData{
+					Address:       signerAddr.String(),
+					ChainID:       clientCtx.ChainID,
+					AccountNumber: accountNumber,
+					Sequence:      sequence + uint64(i),
+					PubKey:        aatypes.NewNilPubKey(signerAddr),
+				}
+
+				txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+				if err != nil {
+					return err
+				}
+
+				signBytes[i], err = getAccountSignBytes(clientCtx, txBuilder, signerData)
+				if err != nil {
+					return fmt.Errorf("tx %d: %w", i, err)
+				}
+				if printSignBytes {
+					continue
+				}
+
+				signature := authenticatorSignature{AuthenticatorID: authenticatorID}
+				if signatures != nil {
+					signature = signatures[i]
+				} else {
+					signature.Signature, _, err = clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes[i])
+					if err != nil {
+						return fmt.Errorf("tx %d: %w", i, err)
+					}
+				}
+
+				if err := setAccountSignature(txBuilder, signerData, signature); err != nil {
+					return fmt.Errorf("tx %d: %w", i, err)
+				}
+				signedTxs[i] = txBuilder.GetTx()
+			}
+
+			if printSignBytes {
+				bz, err := json.Marshal(signBytes)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintRaw(bz)
+			}
+
+			if clientCtx.GenerateOnly || clientCtx.Offline {
+				return writeSignedTxs(cmd, clientCtx, signedTxs)
+			}
+
+			for _, signedTx := range signedTxs {
+				bz, err := clientCtx.TxConfig.TxEncoder()(signedTx)
+				if err != nil {
+					return err
+				}
+
+				res, err := clientCtx.BroadcastTx(bz)
+				if err != nil {
+					return err
+				}
+
+				if err := clientCtx.PrintProto(res); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint8(flagAuthenticatorID, 0, "Authenticator index locator")
+	cmd.Flags().Bool(flagBatch, false, "Sign a batch of transactions, one per line of the file")
+	cmd.Flags().String(flagSignatureFile, "", "Read the authenticator signatures from a file instead of signing with the keyring")
+	cmd.Flags().Bool(flagPrintSignBytes, false, "Print the sign bytes of the transactions instead of signing them")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	return cmd
+}
+
+// readTxs reads a transaction, or a batch of transactions with one per line,
+// from a file
+func readTxs(clientCtx client.Context, path string, batch bool) ([]sdk.Tx, error) {
+	if !batch {
+		stdTx, err := authclient.ReadTxFromFile(clientCtx, path)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Tx{stdTx}, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var txs []sdk.Tx
+	scanner := authclient.NewBatchScanner(clientCtx.TxConfig, file)
+	for scanner.Scan() {
+		txs = append(txs, scanner.Tx())
+	}
+	if err := scanner.UnmarshalErr(); err != nil {
+		return nil, err
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, fmt.Errorf("no transactions in %s", path)
+	}
+
+	return txs, nil
+}
+
+// readAuthenticatorSignatures reads one authenticator signature per
+// transaction from a file
+func readAuthenticatorSignatures(path string, count int) ([]authenticatorSignature, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var signatures []authenticatorSignature
+	if err := json.Unmarshal(bz, &signatures); err != nil {
+		return nil, fmt.Errorf("error decoding signatures: %w", err)
+	}
+
+	if len(signatures) != count {
+		return nil, fmt.Errorf("expected %d signatures, got %d", count, len(signatures))
+	}
+
+	for i, signature := range signatures {
+		if len(signature.Signature) == 0 {
+			return nil, fmt.Errorf("signature %d is empty", i)
+		}
+	}
+
+	return signatures, nil
+}
+
+// getSignerAccount returns the account number and sequence of the signer,
+// taken from the flags in offline mode and from the chain otherwise
+func getSignerAccount(cmd *cobra.Command, clientCtx client.Context, signerAddr sdk.AccAddress) (uint64, uint64, error) {
+	if clientCtx.Offline {
+		if !cmd.Flags().Changed(flags.FlagAccountNumber) || !cmd.Flags().Changed(flags.FlagSequence) {
+			return 0, 0, fmt.Errorf("--%s and --%s are required in offline mode", flags.FlagAccountNumber, flags.FlagSequence)
+		}
+
+		accountNumber, err := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		sequence, err := cmd.Flags().GetUint64(flags.FlagSequence)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		return accountNumber, sequence, nil
+	}
+
+	signerAcc, err := getAbstractAccount(cmd.Context(), authtypes.NewQueryClient(clientCtx), signerAddr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return signerAcc.GetAccountNumber(), signerAcc.GetSequence(), nil
+}
+
+// getAccountSignBytes sets an empty signature for the signer on the
+// transaction and returns the bytes its authenticator has to sign
+func getAccountSignBytes(clientCtx client.Context, txBuilder client.TxBuilder, signerData authsigning.SignerData) ([]byte, error) {
+	sig := signing.SignatureV2{
+		PubKey: signerData.PubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: nil,
+		},
+		Sequence: signerData.Sequence,
+	}
+
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return nil, err
+	}
+
+	return clientCtx.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+}
+
+// setAccountSignature sets the signature of an authenticator, prefixed with
+// its id as the account contract expects
+func setAccountSignature(txBuilder client.TxBuilder, signerData authsigning.SignerData, signature authenticatorSignature) error {
+	sig := signing.SignatureV2{
+		PubKey: signerData.PubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: append([]byte{signature.AuthenticatorID}, signature.Signature...),
+		},
+		Sequence: signerData.Sequence,
+	}
+
+	return txBuilder.SetSignatures(sig)
+}
+
+// writeSignedTxs writes the signed transactions as JSON, one per line, to
+// the output document or the command output
+func writeSignedTxs(cmd *cobra.Command, clientCtx client.Context, txs []sdk.Tx) error {
+	outputDoc, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = cmd.OutOrStdout()
+	if outputDoc != "" {
+		file, err := os.OpenFile(outputDoc, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	for _, signedTx := range txs {
+		bz, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(out, "%s\n", bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getSignerOfTxs(txs []sdk.Tx) (sdk.AccAddress, error) {
+	var signerAddr sdk.AccAddress
+	for _, stdTx := range txs {
+		for i, msg := range stdTx.GetMsgs() {
+			signers := msg.GetSigners()
+			if len(signers) != 1 {
+				return nil, fmt.Errorf("msg %d has more than one signers", i)
+			}
+
+			if signerAddr != nil && !signerAddr.Equals(signers[0]) {
+				return nil, errors.New("tx has more than one signers")
+			}
+
+			signerAddr = signers[0]
+		}
+	}
+
+	if signerAddr == nil {
+		return nil, errors.New("tx has no signer")
+	}
+
+	return signerAddr, nil
+}
+
+func getAbstractAccount(ctx context.Context, queryClient authtypes.QueryClient, signerAddr sdk.AccAddress) (*aatypes.AbstractAccount, error) {
+	res, err := queryClient.Account(ctx, &authtypes.QueryAccountRequest{Address: signerAddr.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Account.TypeUrl != typeURL((*aatypes.AbstractAccount)(nil)) {
+		return nil, fmt.Errorf("signer %s is not an AbstractAccount", signerAddr.String())
+	}
+
+	acc := &aatypes.AbstractAccount{}
+	if err = proto.Unmarshal(res.Account.Value, acc); err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
+
+func typeURL(x proto.Message) string {
+	return "/" + proto.MessageName(x)
+}
@@ -1,15 +1,22 @@
 package cli_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/burnt-labs/xion/x/xion/client/cli"
 )
@@ -211,3 +218,132 @@ func (s *CLITestSuite) TestMultiSendTxCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestSignCmdOffline() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	key, err := s.kr.Key(accounts[0].Name)
+	s.Require().NoError(err)
+	pubKey, err := key.GetPubKey()
+	s.Require().NoError(err)
+
+	// the abstract account is only known by its address offline
+	accountAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	dir := s.T().TempDir()
+
+	var unsignedTxs []byte
+	for i := 0; i < 2; i++ {
+		txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(accountAddr, accountAddr, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(int64(i+1)))))))
+		txBuilder.SetGasLimit(200000)
+		bz, err := s.encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+		s.Require().NoError(err)
+		unsignedTxs = append(append(unsignedTxs, bz...), '\n')
+	}
+	txFile := filepath.Join(dir, "txs.json")
+	s.Require().NoError(os.WriteFile(txFile, unsignedTxs, 0o600))
+
+	offlineArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=7", flags.FlagAccountNumber),
+		fmt.Sprintf("--%s=3", flags.FlagSequence),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=true", "batch"),
+	}
+
+	execute := func(out io.Writer, args ...string) error {
+		cmd := cli.NewSignCmd()
+		cmd.SetOutput(io.Discard)
+		cmd.SetContext(svrcmd.CreateExecuteContext(context.Background()))
+		cmd.SetArgs(append([]string{accounts[0].Name, txFile}, args...))
+		s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx.WithOutput(out), cmd))
+		return cmd.Execute()
+	}
+
+	readSignedTxs := func(path string) []sdk.Tx {
+		bz, err := os.ReadFile(path)
+		s.Require().NoError(err)
+		var txs []sdk.Tx
+		for _, line := range bytes.Split(bytes.TrimSpace(bz), []byte("\n")) {
+			signedTx, err := s.encCfg.TxConfig.TxJSONDecoder()(line)
+			s.Require().NoError(err)
+			txs = append(txs, signedTx)
+		}
+		return txs
+	}
+
+	s.Run("account number and sequence are required", func() {
+		err := execute(io.Discard, fmt.Sprintf("--%s=true", flags.FlagOffline), fmt.Sprintf("--%s=test-chain", flags.FlagChainID))
+		s.Require().ErrorContains(err, "offline mode")
+	})
+
+	s.Run("sign with the keyring", func() {
+		outputDoc := filepath.Join(dir, "signed.json")
+		s.Require().NoError(execute(io.Discard, append(offlineArgs,
+			fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, outputDoc),
+			fmt.Sprintf("--%s=1", "authenticator-id"),
+		)...))
+
+		signedTxs := readSignedTxs(outputDoc)
+		s.Require().Len(signedTxs, 2)
+		for i, signedTx := range signedTxs {
+			sigTx := signedTx.(authsigning.SigVerifiableTx)
+			sigs, err := sigTx.GetSignaturesV2()
+			s.Require().NoError(err)
+			s.Require().Len(sigs, 1)
+			s.Require().Equal(uint64(3+i), sigs[0].Sequence)
+
+			signBytes, err := s.encCfg.TxConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+				Address:       accountAddr.String(),
+				ChainID:       "test-chain",
+				AccountNumber: 7,
+				Sequence:      uint64(3 + i),
+				PubKey:        sigs[0].PubKey,
+			}, signedTx)
+			s.Require().NoError(err)
+
+			signature := sigs[0].Data.(*signing.SingleSignatureData).Signature
+			s.Require().Equal(byte(1), signature[0])
+			s.Require().True(pubKey.VerifySignature(signBytes, signature[1:]))
+		}
+	})
+
+	s.Run("collect signatures from a file", func() {
+		var out bytes.Buffer
+		s.Require().NoError(execute(&out, append(offlineArgs, "--print-sign-bytes")...))
+
+		var signBytes [][]byte
+		s.Require().NoError(json.Unmarshal(out.Bytes(), &signBytes))
+		s.Require().Len(signBytes, 2)
+
+		var signatures []map[string]interface{}
+		for _, bz := range signBytes {
+			signature, _, err := s.kr.Sign(accounts[0].Name, bz)
+			s.Require().NoError(err)
+			signatures = append(signatures, map[string]interface{}{"authenticator_id": 2, "signature": signature})
+		}
+		signaturesBz, err := json.Marshal(signatures)
+		s.Require().NoError(err)
+		signatureFile := filepath.Join(dir, "signatures.json")
+		s.Require().NoError(os.WriteFile(signatureFile, signaturesBz, 0o600))
+
+		outputDoc := filepath.Join(dir, "collected.json")
+		s.Require().NoError(execute(io.Discard, append(offlineArgs,
+			fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, outputDoc),
+			fmt.Sprintf("--%s=%s", "signature-file", signatureFile),
+		)...))
+
+		signedTxs := readSignedTxs(outputDoc)
+		s.Require().Len(signedTxs, 2)
+		for i, signedTx := range signedTxs {
+			sigs, err := signedTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+			s.Require().NoError(err)
+			signature := sigs[0].Data.(*signing.SingleSignatureData).Signature
+			s.Require().Equal(byte(2), signature[0])
+			s.Require().True(pubKey.VerifySignature(signBytes[i], signature[1:]))
+		}
+
+		// a signature is needed for every transaction
+		s.Require().NoError(os.WriteFile(signatureFile, []byte(`[{"authenticator_id":2,"signature":"AQ=="}]`), 0o600))
+		s.Require().Error(execute(io.Discard, append(offlineArgs, fmt.Sprintf("--%s=%s", "signature-file", signatureFile))...))
+	})
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"encoding/base64"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// NewRegisterWebAuthNCredentialCmd returns a CLI command handler for creating
+// a MsgRegisterWebAuthNCredential transaction.
+func NewRegisterWebAuthNCredentialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-webauthn-credential [rp] [challenge] [registration_response_file]",
+		Short: "Verify a WebAuthn registration response and store the credential on chain",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			rpID, origins, err := getRelyingPartyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterWebAuthNCredential(clientCtx.GetFromAddress(), args[0], args[1], data)
+			msg.RpId = rpID
+			msg.Origins = origins
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addRelyingPartyFlags(cmd)
+
+	return cmd
+}
+
+// NewRemoveWebAuthNCredentialCmd returns a CLI command handler for creating
+// a MsgRemoveWebAuthNCredential transaction.
+func NewRemoveWebAuthNCredentialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-webauthn-credential [credential_id]",
+		Short: "Remove a stored WebAuthn credential, identified by its base64url encoded id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			credentialID, err := base64.RawURLEncoding.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveWebAuthNCredential(clientCtx.GetFromAddress(), credentialID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAuthenticateWebAuthNCredentialCmd returns a CLI command handler for
+// creating a MsgAuthenticateWebAuthNCredential transaction.
+func NewAuthenticateWebAuthNCredentialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authenticate-webauthn-credential [rp] [challenge] [assertion_response_file]",
+		Short: "Verify a WebAuthn assertion against a stored credential and record its sign count",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			rpID, origins, err := getRelyingPartyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAuthenticateWebAuthNCredential(clientCtx.GetFromAddress(), args[0], args[1], data)
+			msg.RpId = rpID
+			msg.Origins = origins
+			msg.UserVerificationRequired, err = cmd.Flags().GetBool(flagUserVerificationRequired)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addRelyingPartyFlags(cmd)
+	cmd.Flags().Bool(flagUserVerificationRequired, false, "Reject assertions in which the user was not verified")
+
+	return cmd
+}
+
+// addRelyingPartyFlags registers the flags selecting the relying party id and
+// the extra origins allowed to act for it
+func addRelyingPartyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagRPID, "", "Relying party id, defaults to the host of rp")
+	cmd.Flags().StringSlice(flagOrigins, nil, "Additional origins allowed for the relying party id")
+}
+
+func getRelyingPartyFlags(cmd *cobra.Command) (string, []string, error) {
+	rpID, err := cmd.Flags().GetString(flagRPID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	origins, err := cmd.Flags().GetStringSlice(flagOrigins)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return rpID, origins, nil
+}
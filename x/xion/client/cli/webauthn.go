@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/burnt-labs/xion/x/xion/client/authenticator"
+)
+
+const (
+	flagAlgorithm            = "algorithm"
+	flagChallenge            = "challenge"
+	flagSkipUserVerification = "skip-user-verification"
+
+	webAuthnKeyDir = "webauthn"
+)
+
+// NewWebAuthnCmd returns the command group of the software WebAuthn
+// authenticator, used to produce registration and assertion responses for
+// local testing without a browser.
+func NewWebAuthnCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "webauthn",
+		Short:                      "Software WebAuthn authenticator",
+		Long:                       "Software WebAuthn authenticator, credentials are stored unencrypted in the webauthn directory of the home directory.",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewWebAuthnCreateCmd(),
+		NewWebAuthnRegisterCmd(),
+		NewWebAuthnAssertCmd(),
+	)
+
+	return cmd
+}
+
+// NewWebAuthnCreateCmd returns a CLI command creating a software credential.
+func NewWebAuthnCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [name]",
+		Short: "Create a software WebAuthn credential",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := webAuthnCredentialPath(cmd, args[0])
+			if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("credential %s already exists", args[0])
+			}
+
+			algorithm, err := cmd.Flags().GetString(flagAlgorithm)
+			if err != nil {
+				return err
+			}
+
+			credential, err := authenticator.NewCredential(algorithm)
+			if err != nil {
+				return err
+			}
+
+			if err := credential.Save(path); err != nil {
+				return err
+			}
+
+			cmd.Println(base64.RawURLEncoding.EncodeToString(credential.ID))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAlgorithm, authenticator.AlgorithmES256, fmt.Sprintf("Credential algorithm, %s or %s", authenticator.AlgorithmES256, authenticator.AlgorithmEdDSA))
+
+	return cmd
+}
+
+// NewWebAuthnRegisterCmd returns a CLI command printing the registration
+// response of a software credential.
+func NewWebAuthnRegisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [name] [rp] [address]",
+		Short: "Print the registration response of a software WebAuthn credential",
+		Long:  "Print the registration response of a software WebAuthn credential, the challenge being the base64url encoded account address unless --challenge is set.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			credential, rp, opts, err := webAuthnCeremonyArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			challenge, err := cmd.Flags().GetString(flagChallenge)
+			if err != nil {
+				return err
+			}
+			if challenge == "" {
+				challenge = authenticator.RegistrationChallenge(args[2])
+			}
+
+			response, err := credential.Register(rp, challenge, opts)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(response))
+			return nil
+		},
+	}
+
+	addWebAuthnCeremonyFlags(cmd)
+	cmd.Flags().String(flagChallenge, "", "Registration challenge, defaults to the base64url encoded address")
+
+	return cmd
+}
+
+// NewWebAuthnAssertCmd returns a CLI command printing an assertion response
+// of a software credential.
+func NewWebAuthnAssertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assert [name] [rp] [challenge] [address]",
+		Short: "Print an assertion response of a software WebAuthn credential",
+		Long:  "Print an assertion response of a software WebAuthn credential, incrementing its stored sign count.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			credential, rp, opts, err := webAuthnCeremonyArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			response, err := credential.Assert(rp, args[2], args[3], opts)
+			if err != nil {
+				return err
+			}
+
+			if err := credential.Save(webAuthnCredentialPath(cmd, args[0])); err != nil {
+				return err
+			}
+
+			cmd.Println(string(response))
+			return nil
+		},
+	}
+
+	addWebAuthnCeremonyFlags(cmd)
+
+	return cmd
+}
+
+func addWebAuthnCeremonyFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagRPID, "", "Relying party id, defaults to the host of rp")
+	cmd.Flags().Bool(flagSkipUserVerification, false, "Report that the user was not verified")
+}
+
+func webAuthnCeremonyArgs(cmd *cobra.Command, args []string) (*authenticator.Credential, *url.URL, authenticator.Options, error) {
+	var opts authenticator.Options
+
+	credential, err := authenticator.LoadCredential(webAuthnCredentialPath(cmd, args[0]))
+	if err != nil {
+		return nil, nil, opts, err
+	}
+
+	rp, err := url.Parse(args[1])
+	if err != nil {
+		return nil, nil, opts, err
+	}
+
+	if opts.RPID, err = cmd.Flags().GetString(flagRPID); err != nil {
+		return nil, nil, opts, err
+	}
+	if opts.SkipUserVerification, err = cmd.Flags().GetBool(flagSkipUserVerification); err != nil {
+		return nil, nil, opts, err
+	}
+
+	return credential, rp, opts, nil
+}
+
+func webAuthnCredentialPath(cmd *cobra.Command, name string) string {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	return filepath.Join(clientCtx.HomeDir, webAuthnKeyDir, name+".json")
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// GetAccountMigration returns the latest abstract account migration
+func (k Keeper) GetAccountMigration(ctx sdk.Context) (migration types.AccountMigration, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccountMigrationKey)
+	if bz == nil {
+		return migration, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &migration)
+	return migration, true
+}
+
+// SetAccountMigration stores the latest abstract account migration
+func (k Keeper) SetAccountMigration(ctx sdk.Context, migration types.AccountMigration) {
+	ctx.KVStore(k.storeKey).Set(types.AccountMigrationKey, k.cdc.MustMarshal(&migration))
+}
+
+// ScheduleAccountMigration pins the new code, makes it the abstract account
+// code in place of the old ones and stores the migration for the end blocker
+// to process. Only one migration may be in progress at a time.
+func (k Keeper) ScheduleAccountMigration(ctx sdk.Context, migration types.AccountMigration) error {
+	if err := migration.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidAccountMigration, err.Error())
+	}
+
+	if current, found := k.GetAccountMigration(ctx); found && !current.IsComplete() {
+		return errorsmod.Wrapf(types.ErrAccountMigrationInProgress, "migration to code %d started at height %d", current.NewCodeId, current.StartHeight)
+	}
+
+	// the account contract should always be pinned
+	if err := k.ContractOpsKeeper.PinCode(ctx, migration.NewCodeId); err != nil {
+		return err
+	}
+
+	aaParams, err := k.AAKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !aaParams.AllowAllCodeIDs {
+		allowedCodeIDs := []uint64{}
+		for _, codeID := range aaParams.AllowedCodeIDs {
+			if codeID != migration.NewCodeId && !containsCodeID(migration.OldCodeIds, codeID) {
+				allowedCodeIDs = append(allowedCodeIDs, codeID)
+			}
+		}
+		allowedCodeIDs = append(allowedCodeIDs, migration.NewCodeId)
+		// the abstract account module requires a sorted allow list
+		sort.Slice(allowedCodeIDs, func(i, j int) bool { return allowedCodeIDs[i] < allowedCodeIDs[j] })
+		aaParams.AllowedCodeIDs = allowedCodeIDs
+		if err := k.AAKeeper.SetParams(ctx, aaParams); err != nil {
+			return err
+		}
+	}
+
+	k.SetAccountMigration(ctx, migration)
+	return nil
+}
+
+func containsCodeID(codeIDs []uint64, codeID uint64) bool {
+	for _, id := range codeIDs {
+		if id == codeID {
+			return true
+		}
+	}
+	return false
+}
+
+// MigrateAccounts migrates the next batch of contracts of the pending
+// abstract account migration. Contracts are read from the wasm contracts by
+// code index past the stored cursor, so every validator migrates the same
+// contracts in the same block and a block reads at most a batch of them. A contract that fails to migrate is
+// left on its code, reported through an event and skipped.
+func (k Keeper) MigrateAccounts(ctx sdk.Context) {
+	migration, found := k.GetAccountMigration(ctx)
+	if !found || migration.IsComplete() {
+		return
+	}
+
+	remaining := migration.BatchSize
+	for remaining > 0 && int(migration.CodeIndex) < len(migration.OldCodeIds) {
+		codeID := migration.OldCodeIds[migration.CodeIndex]
+
+		// collect the batch first, migrating a contract moves it out of the
+		// index being iterated
+		contracts, cursors := k.contractsByCodeAfter(ctx, codeID, migration.Cursor, remaining)
+
+		for i, contract := range contracts {
+			if err := k.migrateAccount(ctx, contract, migration.NewCodeId, migration.MigrateMsg); err != nil {
+				k.Logger(ctx).Error("failed to migrate account", "contract", contract.String(), "code_id", codeID, "error", err.Error())
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypeAccountMigrationFailed,
+					sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+					sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				))
+				migration.Failed++
+			} else {
+				migration.Migrated++
+			}
+			migration.Cursor = cursors[i]
+		}
+
+		if uint64(len(contracts)) < remaining {
+			// the code has no contracts left past the cursor
+			migration.CodeIndex++
+			migration.Cursor = nil
+		}
+		remaining -= uint64(len(contracts))
+	}
+
+	if int(migration.CodeIndex) == len(migration.OldCodeIds) {
+		k.completeAccountMigration(ctx, &migration)
+	}
+
+	k.SetAccountMigration(ctx, migration)
+}
+
+// contractsByCodeAfter returns up to limit contracts of a code with their
+// cursors, from the first one past cursor in the wasm contracts by code index.
+// Contracts that failed to migrate stay behind the cursor and are not read
+// again.
+func (k Keeper) contractsByCodeAfter(ctx sdk.Context, codeID uint64, cursor []byte, limit uint64) (contracts []sdk.AccAddress, cursors [][]byte) {
+	store := prefix.NewStore(ctx.KVStore(k.wasmStoreKey), wasmtypes.GetContractByCodeIDSecondaryIndexPrefix(codeID))
+
+	var start []byte
+	if len(cursor) > 0 {
+		// the smallest key past the cursor
+		start = append(append([]byte{}, cursor...), 0)
+	}
+
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(contracts)) < limit; iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		contracts = append(contracts, sdk.AccAddress(key[wasmtypes.AbsoluteTxPositionLen:]))
+		cursors = append(cursors, key)
+	}
+
+	return contracts, cursors
+}
+
+// migrateAccount migrates a single contract in a branch of the state with a
+// bounded gas meter, its writes are only kept if it succeeds
+func (k Keeper) migrateAccount(ctx sdk.Context, contract sdk.AccAddress, newCodeID uint64, msg []byte) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(types.AccountMigrationGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = errorsmod.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+				return
+			}
+			panic(r)
+		}
+	}()
+
+	// accounts are their own contract admin
+	if _, err := k.ContractOpsKeeper.Migrate(cacheCtx, contract, contract, newCodeID, msg); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+func (k Keeper) completeAccountMigration(ctx sdk.Context, migration *types.AccountMigration) {
+	// the previous codes are no longer account targets and need not be
+	// pinned
+	for _, codeID := range migration.OldCodeIds {
+		if err := k.ContractOpsKeeper.UnpinCode(ctx, codeID); err != nil {
+			k.Logger(ctx).Error("failed to unpin code", "code_id", codeID, "error", err.Error())
+		}
+	}
+
+	migration.EndHeight = ctx.BlockHeight()
+	k.Logger(ctx).Info(fmt.Sprintf("account migration completed, migrated %d, failed %d", migration.Migrated, migration.Failed))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAccountMigrationCompleted,
+		sdk.NewAttribute(types.AttributeKeyNewCodeID, strconv.FormatUint(migration.NewCodeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyMigrated, strconv.FormatUint(migration.Migrated, 10)),
+		sdk.NewAttribute(types.AttributeKeyFailed, strconv.FormatUint(migration.Failed, 10)),
+	))
+}
+
+// CancelAccountMigration stops the migration in progress. Contracts left on
+// the old codes keep running them, so the old codes stay pinned.
+func (k Keeper) CancelAccountMigration(ctx sdk.Context) error {
+	migration, found := k.GetAccountMigration(ctx)
+	if !found || migration.IsComplete() {
+		return types.ErrNoAccountMigration
+	}
+
+	migration.Canceled = true
+	migration.EndHeight = ctx.BlockHeight()
+	k.SetAccountMigration(ctx, migration)
+
+	k.Logger(ctx).Info(fmt.Sprintf("account migration canceled, migrated %d, failed %d", migration.Migrated, migration.Failed))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAccountMigrationCanceled,
+		sdk.NewAttribute(types.AttributeKeyNewCodeID, strconv.FormatUint(migration.NewCodeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyMigrated, strconv.FormatUint(migration.Migrated, 10)),
+		sdk.NewAttribute(types.AttributeKeyFailed, strconv.FormatUint(migration.Failed, 10)),
+	))
+	return nil
+}
+
+// RemainingAccountMigrations counts the contracts still on the old code ids
+// of the latest migration
+func (k Keeper) RemainingAccountMigrations(ctx sdk.Context, migration types.AccountMigration) uint64 {
+	var remaining uint64
+	for _, codeID := range migration.OldCodeIds {
+		k.ContractViewKeeper.IterateContractsByCode(ctx, codeID, func(sdk.AccAddress) bool {
+			remaining++
+			return false
+		})
+	}
+	return remaining
+}
@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/x/xion/keeper"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestAccountMigration(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	wasmOps := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	oldCodeID, _, err := wasmOps.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	newCodeID, _, err := wasmOps.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+
+	initMsg, err := json.Marshal(map[string]string{"verifier": creator.String(), "beneficiary": creator.String()})
+	require.NoError(t, err)
+
+	var contracts []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		contract, _, err := wasmOps.Instantiate(ctx, oldCodeID, creator, creator, initMsg, "account", nil)
+		require.NoError(t, err)
+		contracts = append(contracts, contract)
+	}
+	// contracts are migrated in cursor order
+	cursor := func(contract sdk.AccAddress) []byte {
+		history := app.WasmKeeper.GetContractHistory(ctx, contract)
+		return types.AccountMigrationCursor(history[len(history)-1], contract)
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(cursor(contracts[i]), cursor(contracts[j])) < 0
+	})
+	// accounts are their own admin, the second contract is not and cannot
+	// migrate itself
+	require.NoError(t, wasmOps.UpdateContractAdmin(ctx, contracts[0], creator, contracts[0]))
+	require.NoError(t, wasmOps.UpdateContractAdmin(ctx, contracts[2], creator, contracts[2]))
+
+	aaParams, err := app.AbstractAccountKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	aaParams.AllowAllCodeIDs = false
+	aaParams.AllowedCodeIDs = []uint64{oldCodeID}
+	require.NoError(t, app.AbstractAccountKeeper.SetParams(ctx, aaParams))
+
+	// the upgrade does nothing on unknown chains
+	require.NoError(t, keeper.NewMigrator(app.XionKeeper).Migrate1to2(ctx.WithChainID("xion-local-1")))
+	_, found := app.XionKeeper.GetAccountMigration(ctx)
+	require.False(t, found)
+
+	// and only schedules the migration
+	require.NoError(t, keeper.NewMigrator(app.XionKeeper).Migrate1to2(ctx))
+	migration, found := app.XionKeeper.GetAccountMigration(ctx)
+	require.True(t, found)
+	require.Equal(t, []uint64{oldCodeID}, migration.OldCodeIds)
+	require.Equal(t, newCodeID, migration.NewCodeId)
+	require.False(t, migration.IsComplete())
+
+	aaParams, err = app.AbstractAccountKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{newCodeID}, aaParams.AllowedCodeIDs)
+	require.True(t, app.WasmKeeper.IsPinnedCode(ctx, newCodeID))
+	for _, contract := range contracts {
+		require.Equal(t, oldCodeID, app.WasmKeeper.GetContractInfo(ctx, contract).CodeID)
+	}
+
+	// hackatom requires a verifier to migrate
+	migrateMsg, err := json.Marshal(map[string]string{"verifier": creator.String()})
+	require.NoError(t, err)
+	migration = types.NewAccountMigration([]uint64{oldCodeID}, newCodeID, migrateMsg, 2, ctx.BlockHeight())
+	require.NoError(t, migration.Validate())
+	app.XionKeeper.SetAccountMigration(ctx, migration)
+
+	app.XionKeeper.MigrateAccounts(ctx)
+	migration, _ = app.XionKeeper.GetAccountMigration(ctx)
+	require.Equal(t, uint64(1), migration.Migrated)
+	require.Equal(t, uint64(1), migration.Failed)
+	require.False(t, migration.IsComplete())
+	require.Equal(t, newCodeID, app.WasmKeeper.GetContractInfo(ctx, contracts[0]).CodeID)
+	require.Equal(t, oldCodeID, app.WasmKeeper.GetContractInfo(ctx, contracts[1]).CodeID)
+	require.Equal(t, oldCodeID, app.WasmKeeper.GetContractInfo(ctx, contracts[2]).CodeID)
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAccountMigrationFailed {
+			require.Equal(t, contracts[1].String(), string(event.Attributes[0].Value))
+			failed = true
+		}
+	}
+	require.True(t, failed)
+
+	progress, err := app.XionKeeper.AccountMigration(sdk.WrapSDKContext(ctx), &types.QueryAccountMigrationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), progress.Remaining)
+
+	// the failed contract is skipped
+	app.XionKeeper.MigrateAccounts(ctx)
+	migration, _ = app.XionKeeper.GetAccountMigration(ctx)
+	require.Equal(t, uint64(2), migration.Migrated)
+	require.Equal(t, uint64(1), migration.Failed)
+	require.True(t, migration.IsComplete())
+	require.Equal(t, ctx.BlockHeight(), migration.EndHeight)
+	require.Equal(t, newCodeID, app.WasmKeeper.GetContractInfo(ctx, contracts[2]).CodeID)
+	require.False(t, app.WasmKeeper.IsPinnedCode(ctx, oldCodeID))
+
+	progress, err = app.XionKeeper.AccountMigration(sdk.WrapSDKContext(ctx), &types.QueryAccountMigrationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), progress.Remaining)
+
+	// a completed migration is left untouched
+	app.XionKeeper.MigrateAccounts(ctx)
+	completed, _ := app.XionKeeper.GetAccountMigration(ctx)
+	require.Equal(t, migration, completed)
+
+	genesis := app.XionKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, &migration, genesis.AccountMigration)
+}
+
+func TestMsgMigrateAccounts(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.XionKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	wasmOps := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	oldCodeID, _, err := wasmOps.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	newCodeID, _, err := wasmOps.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+
+	initMsg, err := json.Marshal(map[string]string{"verifier": creator.String(), "beneficiary": creator.String()})
+	require.NoError(t, err)
+	contract, _, err := wasmOps.Instantiate(ctx, oldCodeID, creator, creator, initMsg, "account", nil)
+	require.NoError(t, err)
+	require.NoError(t, wasmOps.UpdateContractAdmin(ctx, contract, creator, contract))
+
+	// the second account is created after the first but migrates itself
+	// before, the wasm module then indexes it first
+	migrateMsg, err := json.Marshal(map[string]string{"verifier": creator.String()})
+	require.NoError(t, err)
+	other, _, err := wasmOps.Instantiate(ctx.WithBlockHeight(2), oldCodeID, creator, creator, initMsg, "account", nil)
+	require.NoError(t, err)
+	require.NoError(t, wasmOps.UpdateContractAdmin(ctx, other, creator, other))
+	_, err = wasmOps.Migrate(ctx.WithBlockHeight(3), other, other, newCodeID, migrateMsg)
+	require.NoError(t, err)
+
+	aaParams, err := app.AbstractAccountKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	aaParams.AllowAllCodeIDs = false
+	aaParams.AllowedCodeIDs = []uint64{oldCodeID, 42}
+	require.NoError(t, app.AbstractAccountKeeper.SetParams(ctx, aaParams))
+
+	msg := &types.MsgMigrateAccounts{
+		Authority:  app.XionKeeper.GetAuthority(),
+		OldCodeIds: []uint64{oldCodeID},
+		NewCodeId:  newCodeID,
+		MigrateMsg: migrateMsg,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	unauthorized := *msg
+	unauthorized.Authority = creator.String()
+	_, err = msgServer.MigrateAccounts(goCtx, &unauthorized)
+	require.Error(t, err)
+
+	invalid := *msg
+	invalid.MigrateMsg = []byte("not json")
+	require.ErrorIs(t, invalid.ValidateBasic(), types.ErrInvalidAccountMigration)
+	invalid = *msg
+	invalid.BatchSize = types.MaxAccountMigrationBatchSize + 1
+	require.ErrorIs(t, invalid.ValidateBasic(), types.ErrInvalidAccountMigration)
+
+	_, err = msgServer.MigrateAccounts(goCtx, msg)
+	require.NoError(t, err)
+
+	aaParams, err = app.AbstractAccountKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{newCodeID, 42}, aaParams.AllowedCodeIDs)
+	require.True(t, app.WasmKeeper.IsPinnedCode(ctx, newCodeID))
+
+	// only one migration runs at a time
+	_, err = msgServer.MigrateAccounts(goCtx, msg)
+	require.ErrorIs(t, err, types.ErrAccountMigrationInProgress)
+
+	app.XionKeeper.MigrateAccounts(ctx.WithBlockHeight(4))
+	progress, err := app.XionKeeper.AccountMigration(goCtx, &types.QueryAccountMigrationRequest{})
+	require.NoError(t, err)
+	require.True(t, progress.Migration.IsComplete())
+	require.Equal(t, uint64(1), progress.Migration.Migrated)
+	require.Equal(t, uint64(types.DefaultAccountMigrationBatchSize), progress.Migration.BatchSize)
+	require.Zero(t, progress.Remaining)
+	require.Equal(t, newCodeID, app.WasmKeeper.GetContractInfo(ctx, contract).CodeID)
+
+	// a completed migration can be followed by another one
+	msg.OldCodeIds, msg.NewCodeId, msg.BatchSize = []uint64{newCodeID}, oldCodeID, 1
+	_, err = msgServer.MigrateAccounts(goCtx, msg)
+	require.NoError(t, err)
+
+	// contracts are indexed by their latest migration, the first account
+	// comes after the second one
+	app.XionKeeper.MigrateAccounts(ctx)
+	require.Equal(t, oldCodeID, app.WasmKeeper.GetContractInfo(ctx, other).CodeID)
+	require.Equal(t, newCodeID, app.WasmKeeper.GetContractInfo(ctx, contract).CodeID)
+
+	app.XionKeeper.MigrateAccounts(ctx)
+	app.XionKeeper.MigrateAccounts(ctx)
+	progress, err = app.XionKeeper.AccountMigration(goCtx, &types.QueryAccountMigrationRequest{})
+	require.NoError(t, err)
+	require.True(t, progress.Migration.IsComplete())
+	require.Equal(t, uint64(2), progress.Migration.Migrated)
+	require.Zero(t, progress.Migration.Failed)
+	require.Zero(t, progress.Remaining)
+	require.Equal(t, oldCodeID, app.WasmKeeper.GetContractInfo(ctx, contract).CodeID)
+}
+
+func TestCancelAccountMigration(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.XionKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	wasmOps := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+
+	creator := sdk.AccAddress([]byte("creator_____________"))
+	oldCodeID, _, err := wasmOps.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	newCodeID, _, err := wasmOps.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	require.NoError(t, wasmOps.PinCode(ctx, oldCodeID))
+
+	initMsg, err := json.Marshal(map[string]string{"verifier": creator.String(), "beneficiary": creator.String()})
+	require.NoError(t, err)
+	contract, _, err := wasmOps.Instantiate(ctx, oldCodeID, creator, creator, initMsg, "account", nil)
+	require.NoError(t, err)
+	require.NoError(t, wasmOps.UpdateContractAdmin(ctx, contract, creator, contract))
+
+	cancel := &types.MsgCancelAccountMigration{Authority: app.XionKeeper.GetAuthority()}
+	require.NoError(t, cancel.ValidateBasic())
+	_, err = msgServer.CancelAccountMigration(goCtx, cancel)
+	require.ErrorIs(t, err, types.ErrNoAccountMigration)
+
+	migrateMsg, err := json.Marshal(map[string]string{"verifier": creator.String()})
+	require.NoError(t, err)
+	msg := &types.MsgMigrateAccounts{
+		Authority:  app.XionKeeper.GetAuthority(),
+		OldCodeIds: []uint64{oldCodeID},
+		NewCodeId:  newCodeID,
+		MigrateMsg: migrateMsg,
+	}
+	_, err = msgServer.MigrateAccounts(goCtx, msg)
+	require.NoError(t, err)
+
+	// only the authority cancels a migration
+	_, err = msgServer.CancelAccountMigration(goCtx, &types.MsgCancelAccountMigration{Authority: creator.String()})
+	require.Error(t, err)
+
+	_, err = msgServer.CancelAccountMigration(goCtx, cancel)
+	require.NoError(t, err)
+	migration, found := app.XionKeeper.GetAccountMigration(ctx)
+	require.True(t, found)
+	require.True(t, migration.Canceled)
+	require.True(t, migration.IsComplete())
+	require.Equal(t, ctx.BlockHeight(), migration.EndHeight)
+
+	var canceled bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAccountMigrationCanceled {
+			canceled = true
+		}
+	}
+	require.True(t, canceled)
+
+	// accounts are left on their code, which stays pinned
+	app.XionKeeper.MigrateAccounts(ctx)
+	require.Equal(t, oldCodeID, app.WasmKeeper.GetContractInfo(ctx, contract).CodeID)
+	require.True(t, app.WasmKeeper.IsPinnedCode(ctx, oldCodeID))
+
+	_, err = msgServer.CancelAccountMigration(goCtx, cancel)
+	require.ErrorIs(t, err, types.ErrNoAccountMigration)
+
+	// and can be migrated again
+	_, err = msgServer.MigrateAccounts(goCtx, msg)
+	require.NoError(t, err)
+	app.XionKeeper.MigrateAccounts(ctx)
+	require.Equal(t, newCodeID, app.WasmKeeper.GetContractInfo(ctx, contract).CodeID)
+}
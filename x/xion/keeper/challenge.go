@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// VerifyChallengeBinding checks that a WebAuthn challenge is derived from the
+// transaction described by binding, signed by the account at addr on this
+// chain. The binding must be for the current sequence of the account, so an
+// assertion cannot be replayed once the account signed another transaction. A
+// nil binding leaves the challenge unchecked.
+func (k Keeper) VerifyChallengeBinding(ctx sdk.Context, addr string, challenge string, binding *types.WebAuthnChallengeBinding) error {
+	if binding == nil {
+		return nil
+	}
+
+	accAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, accAddr)
+	if account == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s not found", addr)
+	}
+
+	if binding.Sequence != account.GetSequence() {
+		return errorsmod.Wrapf(types.ErrInvalidChallenge, "sequence %d, expected %d", binding.Sequence, account.GetSequence())
+	}
+
+	return types.VerifyChallenge(challenge, ctx.ChainID(), account.GetAccountNumber(), account.GetSequence(), binding.SignDocHash)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/x/xion/client/authenticator"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestWebAuthnChallengeBinding(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	addr := sdk.MustAccAddressFromBech32(testAddr)
+	account := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, account.SetSequence(5))
+	app.AccountKeeper.SetAccount(ctx, account)
+
+	signDocHash := types.SignDocHash([]byte("sign doc"))
+	challengeRes, err := app.XionKeeper.WebAuthNChallenge(goCtx, &types.QueryWebAuthNChallengeRequest{Addr: testAddr, SignDocHash: signDocHash})
+	require.NoError(t, err)
+	require.Equal(t, "xion-1", challengeRes.ChainId)
+	require.Equal(t, account.GetAccountNumber(), challengeRes.AccountNumber)
+	require.Equal(t, uint64(5), challengeRes.Sequence)
+
+	expected, err := types.DeriveChallenge("xion-1", account.GetAccountNumber(), 5, signDocHash)
+	require.NoError(t, err)
+	require.Equal(t, expected, challengeRes.Challenge)
+
+	rp, err := url.Parse(testRP)
+	require.NoError(t, err)
+	credential, err := authenticator.NewCredential(authenticator.AlgorithmES256)
+	require.NoError(t, err)
+	registerData, err := credential.Register(rp, challengeRes.Challenge, authenticator.Options{})
+	require.NoError(t, err)
+
+	binding := &types.WebAuthnChallengeBinding{SignDocHash: signDocHash, Sequence: 5}
+	registerRes, err := app.XionKeeper.WebAuthNVerifyRegister(goCtx, &types.QueryWebAuthNVerifyRegisterRequest{
+		Addr:             testAddr,
+		Challenge:        challengeRes.Challenge,
+		Rp:               testRP,
+		Data:             registerData,
+		ChallengeBinding: binding,
+	})
+	require.NoError(t, err)
+
+	// a challenge bound to another sequence is rejected
+	_, err = app.XionKeeper.WebAuthNVerifyRegister(goCtx, &types.QueryWebAuthNVerifyRegisterRequest{
+		Addr:             testAddr,
+		Challenge:        challengeRes.Challenge,
+		Rp:               testRP,
+		Data:             registerData,
+		ChallengeBinding: &types.WebAuthnChallengeBinding{SignDocHash: signDocHash, Sequence: 6},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidChallenge)
+
+	assertData, err := credential.Assert(rp, challengeRes.Challenge, testAddr, authenticator.Options{})
+	require.NoError(t, err)
+
+	_, err = app.XionKeeper.WebAuthNVerifyAuthenticate(goCtx, &types.QueryWebAuthNVerifyAuthenticateRequest{
+		Addr:             testAddr,
+		Challenge:        challengeRes.Challenge,
+		Rp:               testRP,
+		Credential:       registerRes.Credential,
+		Data:             assertData,
+		ChallengeBinding: binding,
+	})
+	require.NoError(t, err)
+
+	// the fixture's challenge is not derived from a transaction
+	_, err = app.XionKeeper.WebAuthNVerifyAuthenticate(goCtx, &types.QueryWebAuthNVerifyAuthenticateRequest{
+		Addr:             testAddr,
+		Challenge:        testAuthChallenge,
+		Rp:               testRP,
+		Credential:       registerRes.Credential,
+		Data:             []byte(testAuthData),
+		ChallengeBinding: binding,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidChallenge)
+
+	// once the account signed another transaction the assertion cannot be
+	// replayed, even with the sequence its challenge was derived from
+	require.NoError(t, account.SetSequence(6))
+	app.AccountKeeper.SetAccount(ctx, account)
+	_, err = app.XionKeeper.WebAuthNVerifyAuthenticate(goCtx, &types.QueryWebAuthNVerifyAuthenticateRequest{
+		Addr:             testAddr,
+		Challenge:        challengeRes.Challenge,
+		Rp:               testRP,
+		Credential:       registerRes.Credential,
+		Data:             assertData,
+		ChallengeBinding: binding,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidChallenge)
+}
@@ -9,6 +9,15 @@ import (
 // InitGenesis initializes the bank module's state from a given genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	k.OverwritePlatformPercentage(ctx, genState.PlatformPercentage)
+	k.SetAttestationPolicy(ctx, genState.AttestationPolicy)
+
+	for _, record := range genState.WebauthnCredentials {
+		k.SetWebAuthnCredential(ctx, sdk.MustAccAddressFromBech32(record.Address), *record.Credential)
+	}
+
+	if genState.AccountMigration != nil {
+		k.SetAccountMigration(ctx, *genState.AccountMigration)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -16,5 +25,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	rv := types.NewGenesisState(
 		uint32(k.GetPlatformPercentage(ctx).Uint64()),
 	)
+	rv.WebauthnCredentials = k.GetAllWebAuthnCredentials(ctx)
+	rv.AttestationPolicy = k.GetAttestationPolicy(ctx)
+	if migration, found := k.GetAccountMigration(ctx); found {
+		rv.AccountMigration = &migration
+	}
 	return rv
 }
@@ -3,19 +3,25 @@ package keeper
 import (
 	"bytes"
 	"context"
-	"encoding/json"
-	"net/url"
 
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/burnt-labs/xion/x/xion/types"
 )
 
 var _ types.QueryServer = Keeper{}
 
-func (k Keeper) WebAuthNVerifyRegister(_ context.Context, request *types.QueryWebAuthNVerifyRegisterRequest) (*types.QueryWebAuthNVerifyRegisterResponse, error) {
-	rp, err := url.Parse(request.Rp)
+func (k Keeper) WebAuthNVerifyRegister(goCtx context.Context, request *types.QueryWebAuthNVerifyRegisterRequest) (*types.QueryWebAuthNVerifyRegisterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rp, err := types.NewRelyingParty(request.Rp, request.RpId, request.Origins)
 	if err != nil {
 		return nil, err
 	}
@@ -25,12 +31,20 @@ func (k Keeper) WebAuthNVerifyRegister(_ context.Context, request *types.QueryWe
 		return nil, err
 	}
 
+	if err := k.VerifyChallengeBinding(ctx, request.Addr, request.Challenge, request.ChallengeBinding); err != nil {
+		return nil, err
+	}
+
 	credential, err := types.VerifyRegistration(rp, request.Addr, request.Challenge, data)
 	if err != nil {
 		return nil, err
 	}
 
-	credentialBz, err := json.Marshal(&credential)
+	if err := k.VerifyAttestationPolicy(ctx, data, request.AttestationPolicy); err != nil {
+		return nil, err
+	}
+
+	credentialBz, err := types.NewWebAuthnCredential(credential).Marshal()
 	if err != nil {
 		return nil, err
 	}
@@ -38,8 +52,10 @@ func (k Keeper) WebAuthNVerifyRegister(_ context.Context, request *types.QueryWe
 	return &types.QueryWebAuthNVerifyRegisterResponse{Credential: credentialBz}, nil
 }
 
-func (k Keeper) WebAuthNVerifyAuthenticate(_ context.Context, request *types.QueryWebAuthNVerifyAuthenticateRequest) (*types.QueryWebAuthNVerifyAuthenticateResponse, error) {
-	rp, err := url.Parse(request.Rp)
+func (k Keeper) WebAuthNVerifyAuthenticate(goCtx context.Context, request *types.QueryWebAuthNVerifyAuthenticateRequest) (*types.QueryWebAuthNVerifyAuthenticateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rp, err := types.NewRelyingParty(request.Rp, request.RpId, request.Origins)
 	if err != nil {
 		return nil, err
 	}
@@ -49,16 +65,161 @@ func (k Keeper) WebAuthNVerifyAuthenticate(_ context.Context, request *types.Que
 		return nil, err
 	}
 
-	var credential webauthn.Credential
-	err = json.Unmarshal(request.Credential, &credential)
+	credentialsBz := request.Credentials
+	if len(request.Credential) > 0 {
+		if len(credentialsBz) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "credential and credentials are mutually exclusive")
+		}
+		credentialsBz = [][]byte{request.Credential}
+	}
+
+	credentials := make([]webauthn.Credential, len(credentialsBz))
+	for i, credentialBz := range credentialsBz {
+		credential, err := types.DecodeWebAuthnCredential(credentialBz)
+		if err != nil {
+			return nil, err
+		}
+		credentials[i] = *credential.ToCredential()
+	}
+
+	if err := k.VerifyChallengeBinding(ctx, request.Addr, request.Challenge, request.ChallengeBinding); err != nil {
+		return nil, err
+	}
+
+	index, err := types.SelectCredential(credentials, data)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = types.ValidateAuthentication(rp, request.Addr, request.Challenge, &credentials[index], data, types.UserVerification(request.UserVerificationRequired))
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.NewQueryWebAuthNVerifyAuthenticateResponse(data)
+	res.CredentialIndex = uint32(index)
+	return res, nil
+}
+
+func (k Keeper) WebAuthNVerifyAuthenticateStored(goCtx context.Context, request *types.QueryWebAuthNVerifyAuthenticateStoredRequest) (*types.QueryWebAuthNVerifyAuthenticateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(request.Addr)
 	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rp, err := types.NewRelyingParty(request.Rp, request.RpId, request.Origins)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(request.Data))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.VerifyChallengeBinding(ctx, request.Addr, request.Challenge, request.ChallengeBinding); err != nil {
 		return nil, err
 	}
 
-	_, err = types.VerifyAuthentication(rp, request.Addr, request.Challenge, &credential, data)
+	// queries run against a branch of the state and cannot persist a clone
+	// warning, so an assertion whose counter does not increase is rejected
+	credential, err := k.AuthenticateWebAuthnCredential(ctx, addr, rp, request.Challenge, data, request.UserVerificationRequired)
 	if err != nil {
 		return nil, err
 	}
+	if credential.CloneWarning {
+		return nil, types.ErrWebAuthnCloneWarning
+	}
+
+	return types.NewQueryWebAuthNVerifyAuthenticateResponse(data), nil
+}
+
+func (k Keeper) WebAuthNCredential(goCtx context.Context, request *types.QueryWebAuthNCredentialRequest) (*types.QueryWebAuthNCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(request.Addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	credential, found := k.GetWebAuthnCredential(ctx, addr, request.CredentialId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrWebAuthnCredentialNotFound.Error())
+	}
+
+	return &types.QueryWebAuthNCredentialResponse{Credential: &credential}, nil
+}
+
+func (k Keeper) WebAuthNCredentials(goCtx context.Context, request *types.QueryWebAuthNCredentialsRequest) (*types.QueryWebAuthNCredentialsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(request.Addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var credentials []*types.WebAuthnCredential
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WebAuthnCredentialsKey(addr))
+	pageRes, err := query.Paginate(store, request.Pagination, func(_ []byte, value []byte) error {
+		var credential types.WebAuthnCredential
+		if err := k.cdc.Unmarshal(value, &credential); err != nil {
+			return err
+		}
+		credentials = append(credentials, &credential)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWebAuthNCredentialsResponse{Credentials: credentials, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AttestationPolicy(goCtx context.Context, _ *types.QueryAttestationPolicyRequest) (*types.QueryAttestationPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy := k.GetAttestationPolicy(ctx)
+
+	return &types.QueryAttestationPolicyResponse{Policy: &policy}, nil
+}
+
+func (k Keeper) WebAuthNChallenge(goCtx context.Context, request *types.QueryWebAuthNChallengeRequest) (*types.QueryWebAuthNChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(request.Addr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", request.Addr)
+	}
+
+	challenge, err := types.DeriveChallenge(ctx.ChainID(), account.GetAccountNumber(), account.GetSequence(), request.SignDocHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryWebAuthNChallengeResponse{
+		Challenge:     challenge,
+		ChainId:       ctx.ChainID(),
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+	}, nil
+}
+
+func (k Keeper) AccountMigration(goCtx context.Context, _ *types.QueryAccountMigrationRequest) (*types.QueryAccountMigrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	migration, found := k.GetAccountMigration(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no account migration")
+	}
 
-	return &types.QueryWebAuthNVerifyAuthenticateResponse{}, nil
+	return &types.QueryAccountMigrationResponse{
+		Migration: &migration,
+		Remaining: k.RemainingAccountMigrations(ctx, migration),
+	}, nil
 }
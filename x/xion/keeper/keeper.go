@@ -18,6 +18,7 @@ import (
 type Keeper struct {
 	cdc                codec.BinaryCodec
 	storeKey           storetypes.StoreKey
+	wasmStoreKey       storetypes.StoreKey
 	paramSpace         paramtypes.Subspace
 	bankKeeper         types.BankKeeper
 	accountKeeper      types.AccountKeeper
@@ -32,6 +33,7 @@ type Keeper struct {
 
 func NewKeeper(cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	wasmKey storetypes.StoreKey,
 	paramSpace paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
@@ -42,6 +44,7 @@ func NewKeeper(cdc codec.BinaryCodec,
 ) Keeper {
 	return Keeper{
 		storeKey:           key,
+		wasmStoreKey:       wasmKey,
 		cdc:                cdc,
 		paramSpace:         paramSpace,
 		bankKeeper:         bankKeeper,
@@ -70,6 +73,22 @@ func (k Keeper) OverwritePlatformPercentage(ctx sdktypes.Context, percentage uin
 	ctx.KVStore(k.storeKey).Set(types.PlatformPercentageKey, sdktypes.Uint64ToBigEndian(uint64(percentage)))
 }
 
+// Attestation Policy
+
+func (k Keeper) GetAttestationPolicy(ctx sdktypes.Context) (policy types.AttestationPolicy) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AttestationPolicyKey)
+	if bz == nil {
+		return policy
+	}
+
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy
+}
+
+func (k Keeper) SetAttestationPolicy(ctx sdktypes.Context, policy types.AttestationPolicy) {
+	ctx.KVStore(k.storeKey).Set(types.AttestationPolicyKey, k.cdc.MustMarshal(&policy))
+}
+
 // Authority
 
 // GetAuthority returns the x/xion module's authority.
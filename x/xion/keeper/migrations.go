@@ -1,37 +1,33 @@
 package keeper
 
 import (
-	"fmt"
-
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v1 "github.com/burnt-labs/xion/x/xion/migrations/v1"
-	"github.com/burnt-labs/xion/x/xion/types"
 )
 
 type Migrator struct {
-	wasmOpsKeeper  wasmtypes.ContractOpsKeeper
-	wasmViewKeeper wasmtypes.ViewKeeper
-	aaKeeper       types.AbstractAccountKeeper
+	keeper Keeper
 }
 
-func NewMigrator(wasmOpsKeeper wasmtypes.ContractOpsKeeper, wasmViewKeeper wasmtypes.ViewKeeper, aaKeeper types.AbstractAccountKeeper) Migrator {
-	return Migrator{wasmOpsKeeper: wasmOpsKeeper, wasmViewKeeper: wasmViewKeeper, aaKeeper: aaKeeper}
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
 }
 
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var newCodeID uint64
 	switch ctx.ChainID() {
 	case "xion-mainnet-1":
 		return nil // no migration needed
 	case "xion-testnet-1":
-		newCodeID := uint64(327)
-		return v1.MigrateStore(ctx, m.wasmOpsKeeper, m.wasmViewKeeper, m.aaKeeper, newCodeID)
+		newCodeID = uint64(327)
 	case "xion-1": // integration tests chainID
-		newCodeID := uint64(2)
-		return v1.MigrateStore(ctx, m.wasmOpsKeeper, m.wasmViewKeeper, m.aaKeeper, newCodeID)
+		newCodeID = uint64(2)
 	default:
-		return fmt.Errorf("unsupported chain id: %s", ctx.ChainID())
+		// local and dev networks migrate their accounts with
+		// MsgMigrateAccounts when needed
+		return nil
 	}
+
+	return v1.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.ContractOpsKeeper, m.keeper.AAKeeper, newCodeID)
 }
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
 	"github.com/armon/go-metrics"
+	"github.com/go-webauthn/webauthn/protocol"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -142,3 +144,126 @@ func (k msgServer) SetPlatformPercentage(goCtx context.Context, msg *types.MsgSe
 
 	return &types.MsgSetPlatformPercentageResponse{}, nil
 }
+
+func (k msgServer) RegisterWebAuthNCredential(goCtx context.Context, msg *types.MsgRegisterWebAuthNCredential) (*types.MsgRegisterWebAuthNCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	rp, err := types.NewRelyingParty(msg.Rp, msg.RpId, msg.Origins)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWebAuthnData, err.Error())
+	}
+
+	data, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(msg.Data))
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWebAuthnData, err.Error())
+	}
+
+	verified, err := types.VerifyRegistration(rp, msg.Address, msg.Challenge, data)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWebAuthnData, err.Error())
+	}
+
+	if err := k.VerifyAttestationPolicy(ctx, data, msg.AttestationPolicy); err != nil {
+		return nil, err
+	}
+
+	if owner, found := k.GetWebAuthnCredentialOwner(ctx, verified.ID); found {
+		return nil, errorsmod.Wrapf(types.ErrWebAuthnCredentialExists, "registered to %s", owner)
+	}
+
+	credential := types.NewWebAuthnCredential(verified)
+	k.SetWebAuthnCredential(ctx, addr, *credential)
+
+	return &types.MsgRegisterWebAuthNCredentialResponse{Credential: credential}, nil
+}
+
+func (k msgServer) RemoveWebAuthNCredential(goCtx context.Context, msg *types.MsgRemoveWebAuthNCredential) (*types.MsgRemoveWebAuthNCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetWebAuthnCredential(ctx, addr, msg.CredentialId); !found {
+		return nil, types.ErrWebAuthnCredentialNotFound
+	}
+	k.RemoveWebAuthnCredential(ctx, addr, msg.CredentialId)
+
+	return &types.MsgRemoveWebAuthNCredentialResponse{}, nil
+}
+
+func (k msgServer) AuthenticateWebAuthNCredential(goCtx context.Context, msg *types.MsgAuthenticateWebAuthNCredential) (*types.MsgAuthenticateWebAuthNCredentialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	rp, err := types.NewRelyingParty(msg.Rp, msg.RpId, msg.Origins)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWebAuthnData, err.Error())
+	}
+
+	data, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(msg.Data))
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidWebAuthnData, err.Error())
+	}
+
+	// a clone warning is returned rather than failed so that the flag on the
+	// credential is committed, failing would discard it. Callers check the
+	// flag of the returned credential.
+	credential, err := k.AuthenticateWebAuthnCredential(ctx, addr, rp, msg.Challenge, data, msg.UserVerificationRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAuthenticateWebAuthNCredentialResponse{Credential: &credential}, nil
+}
+
+func (k msgServer) SetAttestationPolicy(goCtx context.Context, msg *types.MsgSetAttestationPolicy) (*types.MsgSetAttestationPolicyResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrAttestationPolicy, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetAttestationPolicy(ctx, msg.Policy)
+
+	return &types.MsgSetAttestationPolicyResponse{}, nil
+}
+
+func (k msgServer) MigrateAccounts(goCtx context.Context, msg *types.MsgMigrateAccounts) (*types.MsgMigrateAccountsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ScheduleAccountMigration(ctx, msg.AccountMigration(ctx.BlockHeight())); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateAccountsResponse{}, nil
+}
+
+func (k msgServer) CancelAccountMigration(goCtx context.Context, msg *types.MsgCancelAccountMigration) (*types.MsgCancelAccountMigrationResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CancelAccountMigration(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAccountMigrationResponse{}, nil
+}
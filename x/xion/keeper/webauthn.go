@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/go-webauthn/webauthn/protocol"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+// WebAuthn credentials
+
+// SetWebAuthnCredential stores a credential against an account and indexes
+// its owner by credential id
+func (k Keeper) SetWebAuthnCredential(ctx sdk.Context, addr sdk.AccAddress, credential types.WebAuthnCredential) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.WebAuthnCredentialKey(addr, credential.Id), k.cdc.MustMarshal(&credential))
+	store.Set(types.WebAuthnCredentialOwnerKey(credential.Id), addr)
+}
+
+// GetWebAuthnCredential returns the credential stored for an account
+func (k Keeper) GetWebAuthnCredential(ctx sdk.Context, addr sdk.AccAddress, credentialID []byte) (val types.WebAuthnCredential, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.WebAuthnCredentialKey(addr, credentialID))
+	if bz == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &val)
+	return val, true
+}
+
+// GetWebAuthnCredentialOwner returns the account a credential id is
+// registered to, if any
+func (k Keeper) GetWebAuthnCredentialOwner(ctx sdk.Context, credentialID []byte) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.WebAuthnCredentialOwnerKey(credentialID))
+	if bz == nil {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// RemoveWebAuthnCredential deletes a credential and its owner index entry
+func (k Keeper) RemoveWebAuthnCredential(ctx sdk.Context, addr sdk.AccAddress, credentialID []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.WebAuthnCredentialKey(addr, credentialID))
+	store.Delete(types.WebAuthnCredentialOwnerKey(credentialID))
+}
+
+// IterateWebAuthnCredentials iterates over the credentials of an account,
+// stopping when the callback returns true
+func (k Keeper) IterateWebAuthnCredentials(ctx sdk.Context, addr sdk.AccAddress, cb func(credential types.WebAuthnCredential) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WebAuthnCredentialsKey(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var credential types.WebAuthnCredential
+		k.cdc.MustUnmarshal(iterator.Value(), &credential)
+		if cb(credential) {
+			break
+		}
+	}
+}
+
+// GetAllWebAuthnCredentials returns every stored credential with its owner
+func (k Keeper) GetAllWebAuthnCredentials(ctx sdk.Context) (list []types.WebAuthnCredentialRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WebAuthnCredentialOwnerPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		owner := sdk.AccAddress(iterator.Value())
+		credential, found := k.GetWebAuthnCredential(ctx, owner, iterator.Key())
+		if !found {
+			continue
+		}
+		list = append(list, types.WebAuthnCredentialRecord{
+			Address:    owner.String(),
+			Credential: &credential,
+		})
+	}
+
+	return
+}
+
+// VerifyAttestationPolicy checks a registration against the module
+// attestation policy and, if given, the policy of the request
+func (k Keeper) VerifyAttestationPolicy(ctx sdk.Context, data *protocol.ParsedCredentialCreationData, requestPolicy *types.AttestationPolicy) error {
+	policies := []types.AttestationPolicy{k.GetAttestationPolicy(ctx)}
+	if requestPolicy != nil {
+		if err := requestPolicy.Validate(); err != nil {
+			return errorsmod.Wrap(types.ErrAttestationPolicy, err.Error())
+		}
+		policies = append(policies, *requestPolicy)
+	}
+
+	for _, policy := range policies {
+		if err := policy.Verify(data, ctx.BlockTime()); err != nil {
+			return errorsmod.Wrap(types.ErrAttestationPolicy, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// AuthenticateWebAuthnCredential verifies an assertion against the stored
+// credential it names and persists the new sign count. An assertion whose
+// counter does not increase flags the credential as possibly cloned, the msg
+// returns the flag so it is committed while queries reject the assertion. A
+// flagged credential is rejected for every later assertion.
+func (k Keeper) AuthenticateWebAuthnCredential(ctx sdk.Context, addr sdk.AccAddress, rp *types.RelyingParty, challenge string, data *protocol.ParsedCredentialAssertionData, userVerificationRequired bool) (types.WebAuthnCredential, error) {
+	credential, found := k.GetWebAuthnCredential(ctx, addr, data.RawID)
+	if !found {
+		return credential, types.ErrWebAuthnCredentialNotFound
+	}
+	if credential.CloneWarning {
+		return credential, types.ErrWebAuthnCloneWarning
+	}
+
+	verified, err := types.ValidateAuthentication(rp, addr.String(), challenge, credential.ToCredential(), data, types.UserVerification(userVerificationRequired))
+	if err != nil {
+		return credential, errorsmod.Wrap(types.ErrInvalidWebAuthnData, err.Error())
+	}
+
+	if verified.Authenticator.CloneWarning {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeWebAuthnCloneWarning,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyCredentialID, base64.RawURLEncoding.EncodeToString(credential.Id)),
+			sdk.NewAttribute(types.AttributeKeySignCount, strconv.FormatUint(uint64(data.Response.AuthenticatorData.Counter), 10)),
+			sdk.NewAttribute(types.AttributeKeyStoredSignCount, strconv.FormatUint(uint64(credential.SignCount), 10)),
+		))
+	}
+
+	credential.SignCount = verified.Authenticator.SignCount
+	credential.CloneWarning = verified.Authenticator.CloneWarning
+	credential.UserPresent = verified.Flags.UserPresent
+	credential.UserVerified = verified.Flags.UserVerified
+	credential.BackupState = verified.Flags.BackupState
+	k.SetWebAuthnCredential(ctx, addr, credential)
+
+	return credential, nil
+}
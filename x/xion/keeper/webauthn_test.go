@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dvsekhvalnov/jose2go/base64url"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	xionapp "github.com/burnt-labs/xion/app"
+	"github.com/burnt-labs/xion/x/xion/keeper"
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+const (
+	testRP            = "https://xion-dapp-example-git-feat-faceid-burntfinance.vercel.app"
+	testAddr          = "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq"
+	testAuthChallenge = "MfaOZjuIdKFbXkLKWbPghSL8w41RsK2Issp4i0TwzvU="
+	testRegisterData  = `{"id":"UWxY-yRdIls8IT-vyMS6la1ZiqESOAff7bWZ_LWV0Pg","type":"public-key","rawId":"VVd4WS15UmRJbHM4SVQtdnlNUzZsYTFaaXFFU09BZmY3YldaX0xXVjBQZw","authenticatorAttachment":"platform","response":{"clientDataJSON":"eyJ0eXBlIjoid2ViYXV0aG4uY3JlYXRlIiwiY2hhbGxlbmdlIjoiZUdsdmJqRnVZM2d3WVRCcWJuTjVZWGszZFdSa01ETmhhREpuWmpZME56Y3laekF5Y1hOM2FqVXlPVGsyWkhrNE1IRm1kbWR1YlhweE5tVndiSEZ4Iiwib3JpZ2luIjoiaHR0cHM6Ly94aW9uLWRhcHAtZXhhbXBsZS1naXQtZmVhdC1mYWNlaWQtYnVybnRmaW5hbmNlLnZlcmNlbC5hcHAifQ","attestationObject":"o2NmbXRkbm9uZWhBdXRoRGF0YaVkcnBpZFggsGMBiDcEppiMfxQ10TPCe2-FaKrLeTkvpzxczngTMw1lZmxhZ3MYRWhhdHRfZGF0YaNmYWFndWlkUEFBR1VJREFBR1VJREFBPT1qcHVibGljX2tleVkCEKQBAwM5AQAgWQIAolg7TF3aai-wR4HTDe5oR-WRhEsdW3u-O3IJHl0BiHkmR4MLskHG9HzivWoXsloUBnBMrFNxOH0x5cNMI07oi4PeRbHySiogRW9CXPjJaNlTi-pT_IgKFsyJNXsLyzrnajLkDbQU6pRsHmNeL0hAOUv48rtXv8VVWWN8okJehD2q9N7LHoFAOmIUEPg_VTHTt8K__O-9eMZKN4eMjh_4-sxRX6NXPSPT87XRlrK4GZ4pUdp86K0tOFLhwO4Uj0JkMNfI82eVZ1tAbDlqjd8jFnAb8fWm8wtdaTNbL_AAXmbDhswwJOyrw8fARZIhrXSdKBWa6e4k7sLwTIy-OO8saebnlARsjGst7ZCzmw5KCm2ctEVl3hYhHwyXu_A5rOblMrV3H0G7WqeKMCMVSJ11ssrlsmfVhNIwu1Qlt5GYmPTTJiCgGUGRxZkgDyOyjFNHglYpZamCGyJ9oyofsukEGoqMQ6WzjFi_hjVapzXi7Li-Q0OjEopIUUDDgeUrgjbGY0eiHI6sAz5hoaD0Qjc9e3Hk6-y7VcKCTCAanZOlJV0vJkHB98LBLh9qAoVUei_VaLFe2IcfVlrL_43aXlsHhr_SUQY5pHPlUMbQihE_57dpPRh31qDX_w6ye8dilniP8JmpKM2uIwnJ0x7hfJ45Qa0oLHmrGlzY9wi-RGP0YUkhQwEAAW1jcmVkZW50aWFsX2lkWCtVV3hZLXlSZElsczhJVC12eU1TNmxhMVppcUVTT0FmZjdiV1pfTFdWMFBnaGV4dF9kYXRh9mpzaWduX2NvdW50AGhhdXRoRGF0YVkCcrBjAYg3BKaYjH8UNdEzwntvhWiqy3k5L6c8XM54EzMNRQAAAABBQUdVSURBQUdVSURBQT09ACtVV3hZLXlSZElsczhJVC12eU1TNmxhMVppcUVTT0FmZjdiV1pfTFdWMFBnpAEDAzkBACBZAgCiWDtMXdpqL7BHgdMN7mhH5ZGESx1be747cgkeXQGIeSZHgwuyQcb0fOK9aheyWhQGcEysU3E4fTHlw0wjTuiLg95FsfJKKiBFb0Jc-Mlo2VOL6lP8iAoWzIk1ewvLOudqMuQNtBTqlGweY14vSEA5S_jyu1e_xVVZY3yiQl6EPar03ssegUA6YhQQ-D9VMdO3wr_87714xko3h4yOH_j6zFFfo1c9I9PztdGWsrgZnilR2nzorS04UuHA7hSPQmQw18jzZ5VnW0BsOWqN3yMWcBvx9abzC11pM1sv8ABeZsOGzDAk7KvDx8BFkiGtdJ0oFZrp7iTuwvBMjL447yxp5ueUBGyMay3tkLObDkoKbZy0RWXeFiEfDJe78Dms5uUytXcfQbtap4owIxVInXWyyuWyZ9WE0jC7VCW3kZiY9NMmIKAZQZHFmSAPI7KMU0eCVillqYIbIn2jKh-y6QQaioxDpbOMWL-GNVqnNeLsuL5DQ6MSikhRQMOB5SuCNsZjR6IcjqwDPmGhoPRCNz17ceTr7LtVwoJMIBqdk6UlXS8mQcH3wsEuH2oChVR6L9VosV7Yhx9WWsv_jdpeWweGv9JRBjmkc-VQxtCKET_nt2k9GHfWoNf_DrJ7x2KWeI_wmakoza4jCcnTHuF8njlBrSgseasaXNj3CL5EY_RhSSFDAQAB"}}`
+	testAuthData      = `{"id":"UWxY-yRdIls8IT-vyMS6la1ZiqESOAff7bWZ_LWV0Pg","type":"public-key","rawId":"VVd4WS15UmRJbHM4SVQtdnlNUzZsYTFaaXFFU09BZmY3YldaX0xXVjBQZw","authenticatorAttachment":"platform","response":{"clientDataJSON":"eyJ0eXBlIjoid2ViYXV0aG4uZ2V0IiwiY2hhbGxlbmdlIjoiTWZhT1pqdUlkS0ZiWGtMS1diUGdoU0w4dzQxUnNLMklzc3A0aTBUd3p2VT0iLCJvcmlnaW4iOiJodHRwczovL3hpb24tZGFwcC1leGFtcGxlLWdpdC1mZWF0LWZhY2VpZC1idXJudGZpbmFuY2UudmVyY2VsLmFwcCJ9","authenticatorData":"sGMBiDcEppiMfxQ10TPCe2-FaKrLeTkvpzxczngTMw1FAAAAAEFBR1VJREFBR1VJREFBPT0AK1VXeFkteVJkSWxzOElULXZ5TVM2bGExWmlxRVNPQWZmN2JXWl9MV1YwUGekAQMDOQEAIFkCAKJYO0xd2movsEeB0w3uaEflkYRLHVt7vjtyCR5dAYh5JkeDC7JBxvR84r1qF7JaFAZwTKxTcTh9MeXDTCNO6IuD3kWx8koqIEVvQlz4yWjZU4vqU_yIChbMiTV7C8s652oy5A20FOqUbB5jXi9IQDlL-PK7V7_FVVljfKJCXoQ9qvTeyx6BQDpiFBD4P1Ux07fCv_zvvXjGSjeHjI4f-PrMUV-jVz0j0_O10ZayuBmeKVHafOitLThS4cDuFI9CZDDXyPNnlWdbQGw5ao3fIxZwG_H1pvMLXWkzWy_wAF5mw4bMMCTsq8PHwEWSIa10nSgVmunuJO7C8EyMvjjvLGnm55QEbIxrLe2Qs5sOSgptnLRFZd4WIR8Ml7vwOazm5TK1dx9Bu1qnijAjFUiddbLK5bJn1YTSMLtUJbeRmJj00yYgoBlBkcWZIA8jsoxTR4JWKWWpghsifaMqH7LpBBqKjEOls4xYv4Y1Wqc14uy4vkNDoxKKSFFAw4HlK4I2xmNHohyOrAM-YaGg9EI3PXtx5Ovsu1XCgkwgGp2TpSVdLyZBwffCwS4fagKFVHov1WixXtiHH1Zay_-N2l5bB4a_0lEGOaRz5VDG0IoRP-e3aT0Yd9ag1_8OsnvHYpZ4j_CZqSjNriMJydMe4XyeOUGtKCx5qxpc2PcIvkRj9GFJIUMBAAE","signature":"HoWSrIL-9keuWgvywoD9fxv-AMdGZdw7bYJP2cNnYv_0vKQ6iSmU3WVjE3MvdUDuruE9wYwIuZ-nqUve-56ZTBYmowzZ79PGgCUUNEFFScgH7ShD8McLK90XLKJGEyiTODPlFv2erCCi7pw2o9L3IWDK_B_yFlkYBkhkHI2h3kwcs8aDxcn_hMjHZonxYqm3eB4Syj-FNseCneVYUw8HljSyBVzrMpa4PkukUWTlo46p6HLoe51XMK_UPpXKFnutQkF_DPcwrUzWdgyEZe4B96TZazcRi8-EZtMRKDLrRgzQ1QYe6srqT74FDuMNI8w-0_aUQBUMWPvGGCHZOAUvQV-TnmY5tsAPFpYH5A0Wi5xHw6r5-Gvw9PZH5zss65zA1nHC085w9KGFjhBEkUE_TmzrZTBX6vogt4YIMinA-YxwGUJyF-gbM8-9BkElSSYY3OsAhwlYDERRAE_gw4hoWSNIf2gjZKH0RhLnZY6eViOiqEdnJWnVWbBVL3UMaYvcLvhNakh59OwB0DO2CEGZziw1qQJeN-3d9Rez7ef_gOO5zT1HSYIPHg9Br9z63e0C3abAsg1iNz8kWtvQ_mjypvCL28vaFoXrcYaUHZQogzaqEEGQ-zSwQK-NAsXI_ZKzYSXmbgAv0wFibBMCG_FzE_hYAGHKSQj9tsdxXicBinY","userHandle":"eGlvbjFuY3gwYTBqbnN5YXk3dWRkMDNhaDJnZjY0NzcyZzAycXN3ajUyOTk2ZHk4MHFmdmdubXpxNmVwbHFx"}}`
+)
+
+func TestWebAuthnCredentialRegistry(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.XionKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	addr := sdk.MustAccAddressFromBech32(testAddr)
+	registerMsg := types.NewMsgRegisterWebAuthNCredential(addr, testRP, base64url.Encode([]byte(testAddr)), []byte(testRegisterData))
+	require.NoError(t, registerMsg.ValidateBasic())
+
+	res, err := msgServer.RegisterWebAuthNCredential(goCtx, registerMsg)
+	require.NoError(t, err)
+	credentialID := res.Credential.Id
+	require.NotEmpty(t, credentialID)
+
+	// the same credential cannot be registered twice
+	_, err = msgServer.RegisterWebAuthNCredential(goCtx, registerMsg)
+	require.ErrorIs(t, err, types.ErrWebAuthnCredentialExists)
+
+	credentialRes, err := app.XionKeeper.WebAuthNCredential(goCtx, &types.QueryWebAuthNCredentialRequest{Addr: testAddr, CredentialId: credentialID})
+	require.NoError(t, err)
+	require.Equal(t, res.Credential, credentialRes.Credential)
+
+	credentialsRes, err := app.XionKeeper.WebAuthNCredentials(goCtx, &types.QueryWebAuthNCredentialsRequest{Addr: testAddr})
+	require.NoError(t, err)
+	require.Len(t, credentialsRes.Credentials, 1)
+
+	verifyRes, err := app.XionKeeper.WebAuthNVerifyAuthenticateStored(goCtx, &types.QueryWebAuthNVerifyAuthenticateStoredRequest{
+		Addr:      testAddr,
+		Challenge: testAuthChallenge,
+		Rp:        testRP,
+		Data:      []byte(testAuthData),
+
+		UserVerificationRequired: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryWebAuthNVerifyAuthenticateResponse{
+		CredentialId: credentialID,
+		UserPresent:  true,
+		UserVerified: true,
+	}, verifyRes)
+
+	genesis := app.XionKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.WebauthnCredentials, 1)
+	require.Equal(t, testAddr, genesis.WebauthnCredentials[0].Address)
+
+	_, err = msgServer.RemoveWebAuthNCredential(goCtx, types.NewMsgRemoveWebAuthNCredential(addr, credentialID))
+	require.NoError(t, err)
+	_, found := app.XionKeeper.GetWebAuthnCredentialOwner(ctx, credentialID)
+	require.False(t, found)
+
+	_, err = app.XionKeeper.WebAuthNVerifyAuthenticateStored(goCtx, &types.QueryWebAuthNVerifyAuthenticateStoredRequest{
+		Addr:      testAddr,
+		Challenge: testAuthChallenge,
+		Rp:        testRP,
+		Data:      []byte(testAuthData),
+	})
+	require.ErrorIs(t, err, types.ErrWebAuthnCredentialNotFound)
+}
+
+func TestWebAuthnCredentialSignCount(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.XionKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	addr := sdk.MustAccAddressFromBech32(testAddr)
+	res, err := msgServer.RegisterWebAuthNCredential(goCtx, types.NewMsgRegisterWebAuthNCredential(addr, testRP, base64url.Encode([]byte(testAddr)), []byte(testRegisterData)))
+	require.NoError(t, err)
+
+	authenticateMsg := types.NewMsgAuthenticateWebAuthNCredential(addr, testRP, testAuthChallenge, []byte(testAuthData))
+
+	// authenticators that always report a zero counter are not flagged
+	authRes, err := msgServer.AuthenticateWebAuthNCredential(goCtx, authenticateMsg)
+	require.NoError(t, err)
+	require.False(t, authRes.Credential.CloneWarning)
+
+	// a counter that does not increase is rejected by the query
+	credential := *res.Credential
+	credential.SignCount = 5
+	app.XionKeeper.SetWebAuthnCredential(ctx, addr, credential)
+
+	verifyStored := func() (*types.QueryWebAuthNVerifyAuthenticateResponse, error) {
+		queryCtx, _ := ctx.CacheContext()
+		return app.XionKeeper.WebAuthNVerifyAuthenticateStored(sdk.WrapSDKContext(queryCtx), &types.QueryWebAuthNVerifyAuthenticateStoredRequest{
+			Addr:      testAddr,
+			Challenge: testAuthChallenge,
+			Rp:        testRP,
+			Data:      []byte(testAuthData),
+		})
+	}
+	_, err = verifyStored()
+	require.ErrorIs(t, err, types.ErrWebAuthnCloneWarning)
+
+	// and flagged by the msg, which emits an event and persists the flag
+	authRes, err = msgServer.AuthenticateWebAuthNCredential(goCtx, authenticateMsg)
+	require.NoError(t, err)
+	require.True(t, authRes.Credential.CloneWarning)
+	require.Equal(t, uint32(5), authRes.Credential.SignCount)
+
+	var emitted bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeWebAuthnCloneWarning {
+			emitted = true
+		}
+	}
+	require.True(t, emitted)
+
+	// a flagged credential can no longer authenticate
+	_, err = msgServer.AuthenticateWebAuthNCredential(goCtx, authenticateMsg)
+	require.ErrorIs(t, err, types.ErrWebAuthnCloneWarning)
+	_, err = verifyStored()
+	require.ErrorIs(t, err, types.ErrWebAuthnCloneWarning)
+}
+
+func TestWebAuthnAttestationPolicy(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.XionKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	addr := sdk.MustAccAddressFromBech32(testAddr)
+	registerMsg := types.NewMsgRegisterWebAuthNCredential(addr, testRP, base64url.Encode([]byte(testAddr)), []byte(testRegisterData))
+
+	// only the governance authority can set the module policy
+	policy := types.AttestationPolicy{Formats: []string{"packed"}}
+	_, err := msgServer.SetAttestationPolicy(goCtx, &types.MsgSetAttestationPolicy{Authority: testAddr, Policy: policy})
+	require.Error(t, err)
+	_, err = msgServer.SetAttestationPolicy(goCtx, &types.MsgSetAttestationPolicy{Authority: app.XionKeeper.GetAuthority(), Policy: policy})
+	require.NoError(t, err)
+
+	// the fixture uses a none attestation
+	_, err = msgServer.RegisterWebAuthNCredential(goCtx, registerMsg)
+	require.ErrorIs(t, err, types.ErrAttestationPolicy)
+
+	_, err = msgServer.SetAttestationPolicy(goCtx, &types.MsgSetAttestationPolicy{Authority: app.XionKeeper.GetAuthority()})
+	require.NoError(t, err)
+
+	// a request policy is applied on top of the module policy
+	registerMsg.AttestationPolicy = &types.AttestationPolicy{Aaguids: [][]byte{make([]byte, 16)}}
+	_, err = msgServer.RegisterWebAuthNCredential(goCtx, registerMsg)
+	require.ErrorIs(t, err, types.ErrAttestationPolicy)
+
+	registerMsg.AttestationPolicy = &types.AttestationPolicy{Formats: []string{"none"}}
+	_, err = msgServer.RegisterWebAuthNCredential(goCtx, registerMsg)
+	require.NoError(t, err)
+}
+
+func TestWebAuthNVerifyAuthenticateCredentials(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("xion", "xionpub")
+
+	app := xionapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "xion-1", Time: time.Now().UTC()})
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	registerRes, err := app.XionKeeper.WebAuthNVerifyRegister(goCtx, &types.QueryWebAuthNVerifyRegisterRequest{
+		Addr:      testAddr,
+		Challenge: base64url.Encode([]byte(testAddr)),
+		Rp:        testRP,
+		Data:      []byte(testRegisterData),
+	})
+	require.NoError(t, err)
+
+	otherCredential := []byte(`{"ID":"b3RoZXI=","PublicKey":"","AttestationType":"none"}`)
+	request := &types.QueryWebAuthNVerifyAuthenticateRequest{
+		Addr:        testAddr,
+		Challenge:   testAuthChallenge,
+		Rp:          testRP,
+		Credentials: [][]byte{otherCredential, registerRes.Credential},
+		Data:        []byte(testAuthData),
+	}
+
+	res, err := app.XionKeeper.WebAuthNVerifyAuthenticate(goCtx, request)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.CredentialIndex)
+	require.NotEmpty(t, res.CredentialId)
+
+	// the assertion must match one of the credentials
+	request.Credentials = [][]byte{otherCredential}
+	_, err = app.XionKeeper.WebAuthNVerifyAuthenticate(goCtx, request)
+	require.Error(t, err)
+
+	// credential and credentials cannot both be set
+	request.Credential = registerRes.Credential
+	_, err = app.XionKeeper.WebAuthNVerifyAuthenticate(goCtx, request)
+	require.Error(t, err)
+}
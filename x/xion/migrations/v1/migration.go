@@ -2,20 +2,24 @@ package v1
 
 import (
 	"fmt"
-	"sync"
-	"sync/atomic"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/burnt-labs/xion/x/xion/types"
 )
 
+// MigrateStore schedules the migration of the abstract account contracts to
+// newCodeID. New accounts are registered on the new code immediately, while
+// the existing ones are migrated in batches by the module's end blocker.
 func MigrateStore(
 	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
 	wasmOpsKeeper wasmtypes.ContractOpsKeeper,
-	wasmViewKeeper wasmtypes.ViewKeeper,
 	aaKeeper types.AbstractAccountKeeper,
 	newCodeID uint64,
 ) error {
@@ -36,57 +40,6 @@ func MigrateStore(
 		return err
 	}
 
-	// setup concurrency control
-	var wg sync.WaitGroup
-	errors := make(chan error, 1)
-	defer close(errors)
-	semaphore := make(chan struct{}, 10) // Limits the number of concurrent migrations
-	defer close(semaphore)
-
-	// counter for migrated contracts
-	var migratedCount uint64
-
-	// iterate through all existing accounts at this code ID, and migrate them
-	wasmViewKeeper.IterateContractsByCode(ctx, originalCodeID, func(instance sdk.AccAddress) bool {
-		semaphore <- struct{}{} // acquire semaphore
-		wg.Add(1)
-
-		go func(instance sdk.AccAddress) {
-			defer wg.Done()
-			defer func() { <-semaphore }() // release semaphore
-
-			ctx.Logger().Info("Migrating contract", "instance", instance.String(), "newCodeID", newCodeID)
-			_, err = wasmOpsKeeper.Migrate(ctx, instance, instance, newCodeID, []byte("{}"))
-			if err != nil {
-				ctx.Logger().Error("Error migrating contract", "contract", instance.String(), "error", err.Error())
-				errors <- err
-			} else {
-				// safely increment the counter
-				atomic.AddUint64(&migratedCount, 1)
-			}
-		}(instance)
-
-		return false
-	})
-
-	wg.Wait()
-
-	select {
-	case err = <-errors:
-		return err
-	default:
-		// No errors, proceed
-	}
-
-	ctx.Logger().Info(fmt.Sprintf("Total contracts migrated: %d", migratedCount))
-
-	// as the previous contract is no longer the main account target, it doesn't
-	// need to be pinned
-	err = wasmOpsKeeper.UnpinCode(ctx, originalCodeID)
-	if err != nil {
-		return err
-	}
-
 	// adjust the aa registration endpoint to point at the new code ID
 	aaParams.AllowedCodeIDs = []uint64{newCodeID}
 	err = aaKeeper.SetParams(ctx, aaParams)
@@ -94,5 +47,13 @@ func MigrateStore(
 		return err
 	}
 
+	migration := types.NewAccountMigration([]uint64{originalCodeID}, newCodeID, []byte("{}"), types.DefaultAccountMigrationBatchSize, ctx.BlockHeight())
+	if err := migration.Validate(); err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(types.AccountMigrationKey, cdc.MustMarshal(&migration))
+	ctx.Logger().Info("Scheduled account migration", "originalCodeID", originalCodeID, "newCodeID", newCodeID)
+
 	return nil
 }
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PredictAccountAddress returns the address an abstract account registered
+// by creator with salt gets for the code with the given hash. Accounts are
+// instantiated without fixing the instantiate msg, so it does not take part
+// in the address.
+func PredictAccountAddress(codeHash []byte, creator sdk.AccAddress, salt []byte) (sdk.AccAddress, error) {
+	if len(codeHash) != 32 {
+		return nil, fmt.Errorf("invalid code hash length %d", len(codeHash))
+	}
+	if err := sdk.VerifyAddressFormat(creator); err != nil {
+		return nil, fmt.Errorf("creator: %w", err)
+	}
+	if err := wasmtypes.ValidateSalt(salt); err != nil {
+		return nil, fmt.Errorf("salt: %w", err)
+	}
+
+	return wasmkeeper.BuildContractAddressPredictable(codeHash, creator, salt, []byte{}), nil
+}
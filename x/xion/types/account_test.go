@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestPredictAccountAddress(t *testing.T) {
+	codeHash := sha256.Sum256([]byte("account"))
+	creator := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+
+	addr, err := types.PredictAccountAddress(codeHash[:], creator, []byte("salt"))
+	require.NoError(t, err)
+	require.Equal(t, wasmkeeper.BuildContractAddressPredictable(codeHash[:], creator, []byte("salt"), []byte{}), addr)
+
+	other, err := types.PredictAccountAddress(codeHash[:], creator, []byte("other salt"))
+	require.NoError(t, err)
+	require.NotEqual(t, addr, other)
+
+	_, err = types.PredictAccountAddress(codeHash[:31], creator, []byte("salt"))
+	require.Error(t, err)
+	_, err = types.PredictAccountAddress(codeHash[:], nil, []byte("salt"))
+	require.Error(t, err)
+	_, err = types.PredictAccountAddress(codeHash[:], creator, nil)
+	require.Error(t, err)
+	_, err = types.PredictAccountAddress(codeHash[:], creator, bytes.Repeat([]byte{1}, 65))
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+)
+
+// attestationFormats are the attestation statement formats a policy may
+// require
+var attestationFormats = map[string]bool{
+	"packed":            true,
+	"tpm":               true,
+	"android-key":       true,
+	"android-safetynet": true,
+	"fido-u2f":          true,
+	"apple":             true,
+	"none":              true,
+}
+
+// IsEmpty returns true if the policy accepts any authenticator
+func (p AttestationPolicy) IsEmpty() bool {
+	return len(p.Formats) == 0 && len(p.Aaguids) == 0 && len(p.TrustAnchors) == 0
+}
+
+// Validate checks the policy is well formed
+func (p AttestationPolicy) Validate() error {
+	for _, format := range p.Formats {
+		if !attestationFormats[format] {
+			return fmt.Errorf("unknown attestation format %s", format)
+		}
+	}
+
+	for _, aaguid := range p.Aaguids {
+		if len(aaguid) != 16 {
+			return fmt.Errorf("aaguid %X must be 16 bytes", aaguid)
+		}
+	}
+
+	for i, anchor := range p.TrustAnchors {
+		if _, err := x509.ParseCertificate(anchor); err != nil {
+			return fmt.Errorf("invalid trust anchor %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Verify checks a parsed registration response against the policy. The
+// attestation certificate chain is verified at the given time, which must
+// be the block time for the result to be deterministic.
+func (p AttestationPolicy) Verify(data *protocol.ParsedCredentialCreationData, now time.Time) error {
+	attestation := data.Response.AttestationObject
+
+	if len(p.Formats) > 0 && !containsString(p.Formats, attestation.Format) {
+		return fmt.Errorf("attestation format %s is not allowed", attestation.Format)
+	}
+
+	if len(p.Aaguids) > 0 && !containsBytes(p.Aaguids, attestation.AuthData.AttData.AAGUID) {
+		return fmt.Errorf("authenticator aaguid %X is not allowed", attestation.AuthData.AttData.AAGUID)
+	}
+
+	if len(p.TrustAnchors) > 0 {
+		return p.verifyCertificateChain(attestation.AttStatement, now)
+	}
+
+	return nil
+}
+
+// verifyCertificateChain verifies the x5c chain of an attestation statement
+// against the trust anchors
+func (p AttestationPolicy) verifyCertificateChain(attStatement map[string]interface{}, now time.Time) error {
+	x5c, ok := attStatement["x5c"].([]interface{})
+	if !ok || len(x5c) == 0 {
+		return fmt.Errorf("attestation statement has no certificate chain")
+	}
+
+	var certificates []*x509.Certificate
+	for _, raw := range x5c {
+		der, ok := raw.([]byte)
+		if !ok {
+			return fmt.Errorf("invalid attestation certificate")
+		}
+		certificate, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("invalid attestation certificate: %w", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	roots := x509.NewCertPool()
+	for _, anchor := range p.TrustAnchors {
+		certificate, err := x509.ParseCertificate(anchor)
+		if err != nil {
+			return err
+		}
+		roots.AddCert(certificate)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range certificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return fmt.Errorf("attestation certificate is not trusted: %w", err)
+	}
+
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsBytes(list [][]byte, b []byte) bool {
+	for _, item := range list {
+		if bytes.Equal(item, b) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/require"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func createCertificate(t *testing.T, subject string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, isCA bool) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: subject},
+		NotBefore:             time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:              time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return certificate, key
+}
+
+func TestAttestationPolicyValidate(t *testing.T) {
+	root, _ := createCertificate(t, "root", nil, nil, true)
+
+	require.NoError(t, types.AttestationPolicy{}.Validate())
+	require.NoError(t, types.AttestationPolicy{
+		Formats:      []string{"packed", "tpm", "android-key", "apple"},
+		Aaguids:      [][]byte{make([]byte, 16)},
+		TrustAnchors: [][]byte{root.Raw},
+	}.Validate())
+
+	require.Error(t, types.AttestationPolicy{Formats: []string{"unknown"}}.Validate())
+	require.Error(t, types.AttestationPolicy{Aaguids: [][]byte{make([]byte, 15)}}.Validate())
+	require.Error(t, types.AttestationPolicy{TrustAnchors: [][]byte{[]byte("not a certificate")}}.Validate())
+}
+
+func TestAttestationPolicyFormatsAndAAGUIDs(t *testing.T) {
+	data, err := protocol.ParseCredentialCreationResponseBody(strings.NewReader(testRegisterResponse))
+	require.NoError(t, err)
+	aaguid := data.Response.AttestationObject.AuthData.AttData.AAGUID
+	now := time.Now()
+
+	require.NoError(t, types.AttestationPolicy{}.Verify(data, now))
+	require.NoError(t, types.AttestationPolicy{Formats: []string{"none"}, Aaguids: [][]byte{aaguid}}.Verify(data, now))
+	require.Error(t, types.AttestationPolicy{Formats: []string{"packed"}}.Verify(data, now))
+	require.Error(t, types.AttestationPolicy{Aaguids: [][]byte{make([]byte, 16)}}.Verify(data, now))
+
+	root, _ := createCertificate(t, "root", nil, nil, true)
+	require.Error(t, types.AttestationPolicy{TrustAnchors: [][]byte{root.Raw}}.Verify(data, now))
+}
+
+func TestAttestationPolicyTrustAnchors(t *testing.T) {
+	root, rootKey := createCertificate(t, "root", nil, nil, true)
+	intermediate, intermediateKey := createCertificate(t, "intermediate", root, rootKey, true)
+	leaf, _ := createCertificate(t, "leaf", intermediate, intermediateKey, false)
+	otherRoot, _ := createCertificate(t, "other", nil, nil, true)
+
+	data := &protocol.ParsedCredentialCreationData{}
+	data.Response.AttestationObject.Format = "packed"
+	data.Response.AttestationObject.AttStatement = map[string]interface{}{
+		"x5c": []interface{}{leaf.Raw, intermediate.Raw},
+	}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	policy := types.AttestationPolicy{Formats: []string{"packed"}, TrustAnchors: [][]byte{root.Raw}}
+	require.NoError(t, policy.Verify(data, now))
+
+	// the chain is checked at the given time
+	require.Error(t, policy.Verify(data, time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	policy.TrustAnchors = [][]byte{otherRoot.Raw}
+	require.Error(t, policy.Verify(data, now))
+}
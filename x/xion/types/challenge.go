@@ -0,0 +1,60 @@
+package types
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ChallengeDomain separates derived WebAuthn challenges from any other use of
+// the same inputs
+const ChallengeDomain = "xion/webauthn-challenge/v1"
+
+// SignDocHash returns the hash of a transaction's sign bytes that a derived
+// challenge commits to
+func SignDocHash(signBytes []byte) []byte {
+	hash := sha256.Sum256(signBytes)
+	return hash[:]
+}
+
+// DeriveChallenge returns the base64url encoded WebAuthn challenge binding a
+// signature to a single transaction. It is the SHA-256 hash of the domain,
+// the length prefixed chain ID, the big endian account number and sequence
+// and the sign doc hash, so a signature cannot be replayed on another chain,
+// account or sequence.
+func DeriveChallenge(chainID string, accountNumber, sequence uint64, signDocHash []byte) (string, error) {
+	if chainID == "" {
+		return "", errorsmod.Wrap(ErrInvalidChallenge, "empty chain id")
+	}
+	if len(signDocHash) != sha256.Size {
+		return "", errorsmod.Wrapf(ErrInvalidChallenge, "sign doc hash must be %d bytes, got %d", sha256.Size, len(signDocHash))
+	}
+
+	hasher := sha256.New()
+	hasher.Write([]byte(ChallengeDomain))
+	hasher.Write(binary.AppendUvarint(nil, uint64(len(chainID))))
+	hasher.Write([]byte(chainID))
+	hasher.Write(binary.BigEndian.AppendUint64(nil, accountNumber))
+	hasher.Write(binary.BigEndian.AppendUint64(nil, sequence))
+	hasher.Write(signDocHash)
+
+	return base64.RawURLEncoding.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// VerifyChallenge checks that challenge is the one derived from the given
+// transaction inputs
+func VerifyChallenge(challenge, chainID string, accountNumber, sequence uint64, signDocHash []byte) error {
+	expected, err := DeriveChallenge(chainID, accountNumber, sequence, signDocHash)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(challenge), []byte(expected)) != 1 {
+		return errorsmod.Wrap(ErrInvalidChallenge, "challenge is not derived from the transaction")
+	}
+
+	return nil
+}
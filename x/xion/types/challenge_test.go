@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+func TestDeriveChallenge(t *testing.T) {
+	signDocHash := types.SignDocHash([]byte("sign doc"))
+
+	challenge, err := types.DeriveChallenge("xion-1", 7, 3, signDocHash)
+	require.NoError(t, err)
+	require.Len(t, challenge, 43)
+
+	again, err := types.DeriveChallenge("xion-1", 7, 3, signDocHash)
+	require.NoError(t, err)
+	require.Equal(t, challenge, again)
+
+	// every input changes the challenge
+	for _, other := range []struct {
+		chainID       string
+		accountNumber uint64
+		sequence      uint64
+		signDocHash   []byte
+	}{
+		{"xion-2", 7, 3, signDocHash},
+		{"xion-1", 8, 3, signDocHash},
+		{"xion-1", 7, 4, signDocHash},
+		{"xion-1", 7, 3, types.SignDocHash([]byte("other sign doc"))},
+	} {
+		derived, err := types.DeriveChallenge(other.chainID, other.accountNumber, other.sequence, other.signDocHash)
+		require.NoError(t, err)
+		require.NotEqual(t, challenge, derived)
+	}
+
+	require.NoError(t, types.VerifyChallenge(challenge, "xion-1", 7, 3, signDocHash))
+	require.ErrorIs(t, types.VerifyChallenge(challenge, "xion-1", 7, 4, signDocHash), types.ErrInvalidChallenge)
+
+	_, err = types.DeriveChallenge("", 7, 3, signDocHash)
+	require.ErrorIs(t, err, types.ErrInvalidChallenge)
+	_, err = types.DeriveChallenge("xion-1", 7, 3, []byte("short"))
+	require.ErrorIs(t, err, types.ErrInvalidChallenge)
+}
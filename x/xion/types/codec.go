@@ -19,7 +19,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgSend{}, "xion/MsgSend")
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "xion/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgSetPlatformPercentage{}, "xion/MsgSetPlatformPercentage")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterWebAuthNCredential{}, "xion/MsgRegisterWebAuthNCredential")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveWebAuthNCredential{}, "xion/MsgRemoveWebAuthNCredential")
+	legacy.RegisterAminoMsg(cdc, &MsgAuthenticateWebAuthNCredential{}, "xion/MsgAuthenticateWebAuthNCredential")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAttestationPolicy{}, "xion/MsgSetAttestationPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgMigrateAccounts{}, "xion/MsgMigrateAccounts")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelAccountMigration{}, "xion/MsgCancelAccountMigration")
 
+	registerFeeAllowances(cdc)
+}
+
+// registerFeeAllowances registers the xion fee allowances as concrete
+// implementations of the feegrant FeeAllowanceI interface.
+func registerFeeAllowances(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&AuthzAllowance{}, "xion/AuthzAllowance", nil)
 	cdc.RegisterConcrete(&ContractsAllowance{}, "xion/ContractsAllowance", nil)
 }
@@ -29,6 +41,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 		&MsgSetPlatformPercentage{},
+		&MsgRegisterWebAuthNCredential{},
+		&MsgRemoveWebAuthNCredential{},
+		&MsgAuthenticateWebAuthNCredential{},
+		&MsgSetAttestationPolicy{},
+		&MsgMigrateAccounts{},
+		&MsgCancelAccountMigration{},
 	)
 
 	registry.RegisterInterface(
@@ -56,4 +74,8 @@ func init() {
 	RegisterLegacyAminoCodec(authzcodec.Amino)
 	RegisterLegacyAminoCodec(govcodec.Amino)
 	RegisterLegacyAminoCodec(groupcodec.Amino)
+
+	// MsgGrantAllowance builds its sign bytes from the x/feegrant Amino codec, so the
+	// allowances must be known there for amino JSON signing of xion fee grants
+	registerFeeAllowances(feegrant.ModuleCdc.LegacyAmino)
 }
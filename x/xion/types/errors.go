@@ -8,3 +8,20 @@ const (
 )
 
 var ErrNoAllowedContracts = errorsmod.Register(DefaultCodespace, 2, "no contract addresses specified")
+
+var (
+	ErrWebAuthnCredentialExists   = errorsmod.Register(DefaultCodespace, 3, "webauthn credential already registered")
+	ErrWebAuthnCredentialNotFound = errorsmod.Register(DefaultCodespace, 4, "webauthn credential not found")
+	ErrInvalidWebAuthnData        = errorsmod.Register(DefaultCodespace, 5, "invalid webauthn data")
+	ErrWebAuthnCloneWarning       = errorsmod.Register(DefaultCodespace, 6, "webauthn authenticator may be cloned")
+)
+
+var ErrAttestationPolicy = errorsmod.Register(DefaultCodespace, 7, "attestation does not satisfy policy")
+
+var ErrInvalidChallenge = errorsmod.Register(DefaultCodespace, 8, "invalid webauthn challenge")
+
+var (
+	ErrInvalidAccountMigration    = errorsmod.Register(DefaultCodespace, 9, "invalid account migration")
+	ErrAccountMigrationInProgress = errorsmod.Register(DefaultCodespace, 10, "account migration in progress")
+	ErrNoAccountMigration         = errorsmod.Register(DefaultCodespace, 11, "no account migration in progress")
+)
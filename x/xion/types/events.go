@@ -0,0 +1,25 @@
+package types
+
+// xion module event types
+const (
+	EventTypeWebAuthnCloneWarning = "webauthn_clone_warning"
+
+	AttributeKeyAddress         = "address"
+	AttributeKeyCredentialID    = "credential_id"
+	AttributeKeySignCount       = "sign_count"
+	AttributeKeyStoredSignCount = "stored_sign_count"
+)
+
+// abstract account migration event types
+const (
+	EventTypeAccountMigrationFailed    = "account_migration_failed"
+	EventTypeAccountMigrationCompleted = "account_migration_completed"
+	EventTypeAccountMigrationCanceled  = "account_migration_canceled"
+
+	AttributeKeyContract  = "contract"
+	AttributeKeyCodeID    = "code_id"
+	AttributeKeyNewCodeID = "new_code_id"
+	AttributeKeyError     = "error"
+	AttributeKeyMigrated  = "migrated"
+	AttributeKeyFailed    = "failed"
+)
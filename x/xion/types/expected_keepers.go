@@ -36,6 +36,7 @@ type BankKeeper interface {
 }
 
 type AccountKeeper interface {
+	GetAccount(ctx sdktypes.Context, addr sdktypes.AccAddress) authtypes.AccountI
 	GetModuleAccount(ctx sdktypes.Context, moduleName string) authtypes.ModuleAccountI
 }
 
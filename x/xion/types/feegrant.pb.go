@@ -117,33 +117,33 @@ func init() {
 func init() { proto.RegisterFile("xion/v1/feegrant.proto", fileDescriptor_38e1987a87c7c3e9) }
 
 var fileDescriptor_38e1987a87c7c3e9 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xab, 0xc8, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x62, 0x07, 0x89, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83,
-	0xc5, 0xf4, 0x41, 0x2c, 0x88, 0xb4, 0x94, 0x64, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98,
-	0x97, 0x54, 0x9a, 0xa6, 0x9f, 0x98, 0x57, 0x09, 0x93, 0x4a, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e,
-	0x87, 0xe8, 0x81, 0x70, 0xa0, 0x52, 0x72, 0x10, 0x9e, 0x7e, 0x52, 0x62, 0x71, 0xaa, 0x7e, 0x99,
-	0x61, 0x52, 0x6a, 0x49, 0xa2, 0xa1, 0x7e, 0x72, 0x7e, 0x66, 0x1e, 0x54, 0x5e, 0x30, 0x31, 0x37,
-	0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x85, 0xe4, 0xd1, 0x2d, 0x2a, 0xc9, 0xcc, 0x4d, 0x2d, 0x2e,
-	0x49, 0xcc, 0x2d, 0x80, 0x99, 0x89, 0xae, 0x20, 0xa5, 0xb4, 0x28, 0xb1, 0x04, 0xe4, 0x78, 0xb0,
-	0x88, 0xd2, 0x6f, 0x46, 0x2e, 0x3e, 0xc7, 0xd2, 0x92, 0x8c, 0x2a, 0xc7, 0x9c, 0x9c, 0xfc, 0xf2,
-	0xc4, 0xbc, 0xe4, 0x54, 0xa1, 0x58, 0x2e, 0xce, 0x44, 0x18, 0x47, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x44, 0x0f, 0x62, 0x8c, 0x1e, 0xcc, 0x18, 0x3d, 0xc7, 0xbc, 0x4a, 0x27, 0xcd, 0x53,
-	0x5b, 0x74, 0x55, 0xa1, 0x3e, 0x80, 0x87, 0x0f, 0xd4, 0xdd, 0x7a, 0x6e, 0xa9, 0xa9, 0x70, 0x23,
-	0x3d, 0x83, 0x10, 0x26, 0x0a, 0xd9, 0x72, 0xf1, 0x26, 0x82, 0x2c, 0x8c, 0x07, 0xab, 0x4f, 0x4d,
-	0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0x98, 0x63,
-	0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x0f, 0x58, 0xb9,
-	0x3b, 0x44, 0xb5, 0x95, 0x77, 0xc7, 0x02, 0x79, 0x06, 0xa2, 0x2d, 0xee, 0x7a, 0xbe, 0x41, 0x0b,
-	0x1a, 0xe2, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0xa8, 0x5e, 0x55, 0xea, 0x64, 0xe2, 0x12, 0x72, 0xce,
-	0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0xa6, 0x5b, 0x08, 0xb8, 0x73, 0x09, 0x25, 0x43, 0x2d,
-	0x8d, 0x4f, 0x84, 0x78, 0x35, 0xb5, 0x58, 0x82, 0x49, 0x81, 0x19, 0x6f, 0x30, 0x08, 0xc2, 0xf4,
-	0x38, 0xc2, 0xb4, 0x50, 0x35, 0x2c, 0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x4a, 0x3d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xa9,
-	0xb4, 0x28, 0xaf, 0x44, 0x37, 0x27, 0x31, 0xa9, 0x58, 0x1f, 0x9c, 0x35, 0x2a, 0x20, 0x54, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x70, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x43,
-	0x12, 0x30, 0xb0, 0x36, 0x03, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x51, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x4d, 0xfa, 0xc1, 0x27, 0x8d, 0x3f, 0xd0, 0x58, 0x34, 0x76, 0x91, 0x96, 0x82, 0x58, 0x85,
+	0x66, 0x88, 0xee, 0x0a, 0x2e, 0x52, 0xd1, 0xd2, 0x6d, 0xdd, 0x09, 0x52, 0x26, 0xe9, 0x34, 0x0d,
+	0x24, 0x33, 0x25, 0x33, 0xa9, 0xad, 0x2f, 0xa0, 0xb8, 0xf2, 0x11, 0x7c, 0x04, 0x17, 0x7d, 0x08,
+	0x71, 0x55, 0x5c, 0xb9, 0x94, 0x76, 0xe1, 0x13, 0xb8, 0x97, 0xcc, 0x24, 0x15, 0x2b, 0x88, 0x6e,
+	0xdc, 0x24, 0xb9, 0xf7, 0x9e, 0x7b, 0xce, 0x3d, 0x27, 0xca, 0xc6, 0xd0, 0x23, 0x18, 0x0c, 0x4c,
+	0xd0, 0x45, 0xc8, 0x0d, 0x21, 0x66, 0x46, 0x3f, 0x24, 0x8c, 0xa8, 0x4b, 0x71, 0xdf, 0x18, 0x98,
+	0x85, 0xbc, 0x4b, 0x5c, 0xc2, 0x7b, 0x20, 0xfe, 0x12, 0xe3, 0xc2, 0x96, 0x4b, 0x88, 0xeb, 0x23,
+	0xc0, 0x2b, 0x3b, 0xea, 0x02, 0x88, 0x47, 0xe9, 0xc8, 0x21, 0x34, 0x20, 0xb4, 0x2d, 0x76, 0x44,
+	0x91, 0x8c, 0x74, 0x51, 0x01, 0x1b, 0x52, 0x04, 0x06, 0xa6, 0x8d, 0x18, 0x34, 0x81, 0x43, 0x3c,
+	0x9c, 0xcc, 0x73, 0x30, 0xf0, 0x30, 0x01, 0xfc, 0x99, 0xb4, 0x8a, 0x8b, 0x42, 0xcc, 0x0b, 0x10,
+	0x65, 0x30, 0xe8, 0xa7, 0x9c, 0x8b, 0x80, 0x4e, 0x14, 0x42, 0x16, 0x1f, 0xcf, 0x3b, 0xe5, 0x37,
+	0x59, 0x59, 0xb3, 0x22, 0xd6, 0xbb, 0xb4, 0x7c, 0x9f, 0x5c, 0x40, 0xec, 0x20, 0xf5, 0x5c, 0xc9,
+	0xc2, 0xb4, 0xd0, 0xe4, 0x92, 0x5c, 0x59, 0xde, 0xcf, 0x1b, 0x82, 0xc6, 0x48, 0x69, 0x0c, 0x0b,
+	0x8f, 0xea, 0xbb, 0x8f, 0xe3, 0xea, 0x76, 0xe2, 0x60, 0x9e, 0x4f, 0x72, 0xb7, 0x71, 0x82, 0xd0,
+	0x9c, 0xb2, 0xd9, 0xfa, 0x60, 0x54, 0x0f, 0x95, 0x55, 0x18, 0x0b, 0xb6, 0x39, 0x1e, 0x21, 0x2d,
+	0x53, 0x92, 0x2b, 0xd9, 0xba, 0xf6, 0x34, 0xae, 0xe6, 0x13, 0x32, 0xab, 0xd3, 0x09, 0x11, 0xa5,
+	0xa7, 0x2c, 0xf4, 0xb0, 0xdb, 0x5a, 0xe1, 0xf0, 0x86, 0x40, 0xd7, 0x8e, 0xaf, 0xef, 0x8a, 0xd2,
+	0x8f, 0x85, 0x6f, 0x5e, 0xef, 0xf7, 0xd6, 0xf9, 0x3f, 0xfc, 0x6c, 0xb2, 0x7c, 0x95, 0x51, 0xd4,
+	0x23, 0x82, 0x59, 0x08, 0x1d, 0x46, 0xff, 0xcc, 0x7b, 0x43, 0x51, 0x9d, 0x44, 0xb4, 0x0d, 0x85,
+	0x49, 0x44, 0xb5, 0x4c, 0xe9, 0xdf, 0xb7, 0x01, 0xe4, 0xd2, 0x1d, 0x2b, 0x5d, 0xa9, 0x35, 0x7f,
+	0x9d, 0xc2, 0x26, 0x4f, 0xe1, 0xab, 0xe5, 0xba, 0xf5, 0x30, 0xd5, 0xe5, 0xc9, 0x54, 0x97, 0x5f,
+	0xa6, 0xba, 0x7c, 0x3b, 0xd3, 0xa5, 0xc9, 0x4c, 0x97, 0x9e, 0x67, 0xba, 0x74, 0xb6, 0xe3, 0x7a,
+	0xac, 0x17, 0xd9, 0x86, 0x43, 0x02, 0x60, 0x47, 0x21, 0x66, 0x55, 0x1f, 0xda, 0x14, 0x70, 0xa2,
+	0xa1, 0x78, 0xb1, 0x51, 0x1f, 0x51, 0xfb, 0x3f, 0x8f, 0xe6, 0xe0, 0x7d, 0x00, 0xf1, 0x9f, 0xb2,
+	0xaf, 0x2e, 0x03, 0x00, 0x00,
 }
 
 func (m *AuthzAllowance) Marshal() (dAtA []byte, err error) {
@@ -3,8 +3,10 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Validate performs basic validation of supply genesis data returning an
@@ -14,6 +16,31 @@ func (gs GenesisState) Validate() error {
 		return errors.New("unable to set platform percentage to greater than 100%")
 	}
 
+	if err := gs.AttestationPolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid attestation policy: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, record := range gs.WebauthnCredentials {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return fmt.Errorf("invalid webauthn credential address %s: %w", record.Address, err)
+		}
+		if record.Credential == nil || len(record.Credential.Id) == 0 {
+			return fmt.Errorf("missing webauthn credential for %s", record.Address)
+		}
+		id := string(record.Credential.Id)
+		if seen[id] {
+			return fmt.Errorf("duplicate webauthn credential id %X", record.Credential.Id)
+		}
+		seen[id] = true
+	}
+
+	if gs.AccountMigration != nil {
+		if err := gs.AccountMigration.Validate(); err != nil {
+			return fmt.Errorf("invalid account migration: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -24,7 +24,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	PlatformPercentage uint32 `protobuf:"varint,1,opt,name=platform_percentage,json=platformPercentage,proto3" json:"platform_percentage,omitempty"`
+	PlatformPercentage  uint32                     `protobuf:"varint,1,opt,name=platform_percentage,json=platformPercentage,proto3" json:"platform_percentage,omitempty"`
+	WebauthnCredentials []WebAuthnCredentialRecord `protobuf:"bytes,2,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials"`
+	AttestationPolicy   AttestationPolicy          `protobuf:"bytes,3,opt,name=attestation_policy,json=attestationPolicy,proto3" json:"attestation_policy"`
+	// account_migration is the latest abstract account migration, if any
+	AccountMigration *AccountMigration `protobuf:"bytes,4,opt,name=account_migration,json=accountMigration,proto3" json:"account_migration,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,6 +71,27 @@ func (m *GenesisState) GetPlatformPercentage() uint32 {
 	return 0
 }
 
+func (m *GenesisState) GetWebauthnCredentials() []WebAuthnCredentialRecord {
+	if m != nil {
+		return m.WebauthnCredentials
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationPolicy() AttestationPolicy {
+	if m != nil {
+		return m.AttestationPolicy
+	}
+	return AttestationPolicy{}
+}
+
+func (m *GenesisState) GetAccountMigration() *AccountMigration {
+	if m != nil {
+		return m.AccountMigration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "xion.v1.GenesisState")
 }
@@ -74,19 +99,28 @@ func init() {
 func init() { proto.RegisterFile("xion/v1/genesis.proto", fileDescriptor_9a0f59b6b1baf029) }
 
 var fileDescriptor_9a0f59b6b1baf029 = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xad, 0xc8, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x62, 0x07, 0x09, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xc5,
-	0xf4, 0x41, 0x2c, 0x88, 0xb4, 0x92, 0x3d, 0x17, 0x8f, 0x3b, 0x44, 0x7d, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x90, 0x3e, 0x97, 0x70, 0x41, 0x4e, 0x62, 0x49, 0x5a, 0x7e, 0x51, 0x6e, 0x7c, 0x41, 0x6a,
-	0x51, 0x72, 0x6a, 0x5e, 0x49, 0x62, 0x7a, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x6f, 0x90, 0x10,
-	0x4c, 0x2a, 0x00, 0x2e, 0xe3, 0xe4, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0xea, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x49, 0xa5, 0x45,
-	0x79, 0x25, 0xba, 0x39, 0x89, 0x49, 0xc5, 0xfa, 0x60, 0x67, 0x56, 0x40, 0xa8, 0x92, 0xca, 0x82,
-	0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x53, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x35, 0xe8,
-	0xba, 0xc2, 0x00, 0x00, 0x00,
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4e, 0xc2, 0x30,
+	0x18, 0xc7, 0x37, 0x20, 0x9a, 0x0c, 0x4d, 0xa4, 0xa0, 0x4e, 0x0e, 0x13, 0xbd, 0xc8, 0xc5, 0x2d,
+	0xe0, 0x13, 0x80, 0x89, 0x9e, 0x8c, 0x64, 0x1e, 0x4c, 0xb8, 0x2c, 0x5d, 0xf9, 0x1c, 0x4b, 0xc6,
+	0xba, 0xb4, 0xdf, 0x10, 0xde, 0xc2, 0x87, 0xf1, 0x21, 0x38, 0x72, 0xf4, 0x64, 0x0c, 0xbc, 0x88,
+	0xd9, 0x46, 0x47, 0xc2, 0x69, 0xcb, 0xff, 0xf7, 0xeb, 0xbf, 0xed, 0x57, 0xe3, 0x7c, 0x11, 0xf2,
+	0xd8, 0x99, 0xf7, 0x9c, 0x00, 0x62, 0x90, 0xa1, 0xb4, 0x13, 0xc1, 0x91, 0x93, 0xe3, 0x2c, 0xb6,
+	0xe7, 0xbd, 0x76, 0x2b, 0xe0, 0x01, 0xcf, 0x33, 0x27, 0xfb, 0x2b, 0x70, 0xfb, 0x52, 0xad, 0x9a,
+	0x85, 0x81, 0xa0, 0x98, 0xb9, 0x05, 0xb8, 0x50, 0xe0, 0x13, 0x7c, 0x9a, 0xe2, 0x74, 0x97, 0xdf,
+	0x7e, 0x57, 0x8c, 0x93, 0xe7, 0x62, 0x87, 0x37, 0xa4, 0x08, 0xc4, 0x31, 0x9a, 0x49, 0x44, 0xf1,
+	0x83, 0x8b, 0x99, 0x97, 0x80, 0x60, 0x10, 0x23, 0x0d, 0xc0, 0xd4, 0x3b, 0x7a, 0xf7, 0xd4, 0x25,
+	0x0a, 0x8d, 0x4a, 0x42, 0xc6, 0x46, 0x4b, 0x75, 0x7a, 0x4c, 0xc0, 0x04, 0x62, 0x0c, 0x69, 0x24,
+	0xcd, 0x4a, 0xa7, 0xda, 0xad, 0xf7, 0x6f, 0xec, 0xdd, 0x81, 0xed, 0x77, 0xf0, 0x07, 0x99, 0xf4,
+	0x58, 0x3a, 0x2e, 0x30, 0x2e, 0x26, 0xc3, 0xda, 0xea, 0xf7, 0x5a, 0x73, 0x9b, 0xaa, 0x64, 0xcf,
+	0x25, 0x79, 0x35, 0x08, 0x45, 0x04, 0x89, 0xf9, 0x55, 0xbc, 0x84, 0x47, 0x21, 0x5b, 0x9a, 0xd5,
+	0x8e, 0xde, 0xad, 0xf7, 0xdb, 0x65, 0xf3, 0x60, 0xaf, 0x8c, 0x72, 0x63, 0x57, 0xd9, 0xa0, 0x87,
+	0x80, 0x3c, 0x19, 0x0d, 0xca, 0x18, 0x4f, 0x63, 0xf4, 0xca, 0x09, 0x99, 0xb5, 0xbc, 0xef, 0x6a,
+	0xdf, 0x57, 0x18, 0x2f, 0x4a, 0x70, 0xcf, 0xe8, 0x41, 0x32, 0x1c, 0xac, 0x36, 0x96, 0xbe, 0xde,
+	0x58, 0xfa, 0xdf, 0xc6, 0xd2, 0xbf, 0xb6, 0x96, 0xb6, 0xde, 0x5a, 0xda, 0xcf, 0xd6, 0xd2, 0xc6,
+	0x77, 0x41, 0x88, 0xd3, 0xd4, 0xb7, 0x19, 0x9f, 0x39, 0x7e, 0x2a, 0x62, 0xbc, 0x8f, 0xa8, 0x2f,
+	0x9d, 0x7c, 0xfc, 0x8b, 0xe2, 0x83, 0xcb, 0x04, 0xa4, 0x7f, 0x94, 0x3f, 0xc0, 0xc3, 0xff, 0x00,
+	0xa9, 0xb4, 0xcf, 0x01, 0xe9, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -109,6 +143,42 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AccountMigration != nil {
+		{
+			size, err := m.AccountMigration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.AttestationPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.WebauthnCredentials) > 0 {
+		for iNdEx := len(m.WebauthnCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WebauthnCredentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.PlatformPercentage != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PlatformPercentage))
 		i--
@@ -137,6 +207,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.PlatformPercentage != 0 {
 		n += 1 + sovGenesis(uint64(m.PlatformPercentage))
 	}
+	if len(m.WebauthnCredentials) > 0 {
+		for _, e := range m.WebauthnCredentials {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AttestationPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.AccountMigration != nil {
+		l = m.AccountMigration.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -194,6 +276,109 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebauthnCredentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebauthnCredentials = append(m.WebauthnCredentials, WebAuthnCredentialRecord{})
+			if err := m.WebauthnCredentials[len(m.WebauthnCredentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountMigration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountMigration == nil {
+				m.AccountMigration = &AccountMigration{}
+			}
+			if err := m.AccountMigration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
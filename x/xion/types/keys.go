@@ -1,6 +1,22 @@
 package types
 
-var PlatformPercentageKey = []byte{0x00}
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	PlatformPercentageKey = []byte{0x00}
+
+	// WebAuthnCredentialPrefix stores credentials under address | credential id
+	WebAuthnCredentialPrefix = []byte{0x01}
+	// WebAuthnCredentialOwnerPrefix indexes the owning address by credential id
+	WebAuthnCredentialOwnerPrefix = []byte{0x02}
+	// AttestationPolicyKey stores the attestation policy of registrations
+	AttestationPolicyKey = []byte{0x03}
+	// AccountMigrationKey stores the latest abstract account migration
+	AccountMigrationKey = []byte{0x04}
+)
 
 const (
 	// ModuleName is the module name constant used in many places
@@ -15,3 +31,20 @@ const (
 	// QuerierRoute is the querier route for oracle
 	QuerierRoute = ModuleName
 )
+
+// WebAuthnCredentialsKey returns the prefix under which all the credentials
+// of an account are stored
+func WebAuthnCredentialsKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, WebAuthnCredentialPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// WebAuthnCredentialKey returns the store key of a single credential
+func WebAuthnCredentialKey(addr sdk.AccAddress, credentialID []byte) []byte {
+	return append(WebAuthnCredentialsKey(addr), credentialID...)
+}
+
+// WebAuthnCredentialOwnerKey returns the store key of the owner index entry
+// for a credential id
+func WebAuthnCredentialOwnerKey(credentialID []byte) []byte {
+	return append(append([]byte{}, WebAuthnCredentialOwnerPrefix...), credentialID...)
+}
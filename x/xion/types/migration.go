@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultAccountMigrationBatchSize is the number of contracts migrated per
+	// block when a migration does not set its own
+	DefaultAccountMigrationBatchSize = 50
+
+	// MaxAccountMigrationBatchSize bounds the contracts migrated per block,
+	// each may consume up to AccountMigrationGasLimit
+	MaxAccountMigrationBatchSize = 100
+
+	// AccountMigrationGasLimit bounds the gas a single contract migration may
+	// consume, so one contract cannot stall the end of a block
+	AccountMigrationGasLimit = 20_000_000
+)
+
+// NewAccountMigration returns a migration of the contracts of oldCodeIDs to
+// newCodeID starting at height
+func NewAccountMigration(oldCodeIDs []uint64, newCodeID uint64, migrateMsg []byte, batchSize uint64, height int64) AccountMigration {
+	if batchSize == 0 {
+		batchSize = DefaultAccountMigrationBatchSize
+	}
+
+	return AccountMigration{
+		OldCodeIds:  oldCodeIDs,
+		NewCodeId:   newCodeID,
+		MigrateMsg:  migrateMsg,
+		BatchSize:   batchSize,
+		StartHeight: height,
+	}
+}
+
+// Validate checks the migration is well formed
+func (m AccountMigration) Validate() error {
+	if len(m.OldCodeIds) == 0 {
+		return errors.New("no code ids to migrate from")
+	}
+	if m.NewCodeId == 0 {
+		return errors.New("new code id must be set")
+	}
+
+	seen := make(map[uint64]bool)
+	for _, codeID := range m.OldCodeIds {
+		if codeID == 0 || codeID == m.NewCodeId {
+			return fmt.Errorf("invalid code id to migrate from: %d", codeID)
+		}
+		if seen[codeID] {
+			return fmt.Errorf("duplicate code id to migrate from: %d", codeID)
+		}
+		seen[codeID] = true
+	}
+
+	if !json.Valid(m.MigrateMsg) {
+		return errors.New("migrate msg must be valid json")
+	}
+	if m.BatchSize == 0 {
+		return errors.New("batch size must be positive")
+	}
+	if m.BatchSize > MaxAccountMigrationBatchSize {
+		return fmt.Errorf("batch size %d is more than %d", m.BatchSize, MaxAccountMigrationBatchSize)
+	}
+	if int(m.CodeIndex) > len(m.OldCodeIds) {
+		return fmt.Errorf("code index %d out of range", m.CodeIndex)
+	}
+
+	return nil
+}
+
+// IsComplete reports whether all contracts of the old code ids were
+// processed
+func (m AccountMigration) IsComplete() bool {
+	return m.EndHeight != 0
+}
+
+// AccountMigrationCursor returns the cursor of a contract, its key in the
+// wasm contracts by code index without the code prefix. Contracts are indexed
+// by the position of their latest code history entry and then address.
+func AccountMigrationCursor(entry wasmtypes.ContractCodeHistoryEntry, contract sdk.AccAddress) []byte {
+	key := wasmtypes.GetContractByCreatedSecondaryIndexKey(contract, entry)
+	return key[len(wasmtypes.GetContractByCodeIDSecondaryIndexPrefix(entry.CodeID)):]
+}
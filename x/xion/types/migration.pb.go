@@ -0,0 +1,791 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: xion/v1/migration.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountMigration tracks the migration of the abstract account contracts of
+// old code ids to a new code id. Contracts are migrated in batches at the end
+// of each block.
+type AccountMigration struct {
+	// old_code_ids are the code ids whose contracts are migrated, in order
+	OldCodeIds []uint64 `protobuf:"varint,1,rep,packed,name=old_code_ids,json=oldCodeIds,proto3" json:"old_code_ids,omitempty"`
+	NewCodeId  uint64   `protobuf:"varint,2,opt,name=new_code_id,json=newCodeId,proto3" json:"new_code_id,omitempty"`
+	// migrate_msg is the JSON message passed to each contract's migrate entry
+	// point
+	MigrateMsg []byte `protobuf:"bytes,3,opt,name=migrate_msg,json=migrateMsg,proto3" json:"migrate_msg,omitempty"`
+	// batch_size is the maximum number of contracts migrated per block
+	BatchSize uint64 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// code_index is the index in old_code_ids of the code being migrated
+	CodeIndex uint32 `protobuf:"varint,5,opt,name=code_index,json=codeIndex,proto3" json:"code_index,omitempty"`
+	// cursor is the key of the last processed contract in the wasm contracts
+	// by code index without the code prefix, the position of its latest code
+	// history entry and its address. It is empty before the first contract.
+	Cursor      []byte `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Migrated    uint64 `protobuf:"varint,7,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	Failed      uint64 `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	StartHeight int64  `protobuf:"varint,9,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height the migration completed at, zero while it is
+	// in progress
+	EndHeight int64 `protobuf:"varint,10,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// canceled is set when the migration was canceled before all contracts
+	// were processed
+	Canceled bool `protobuf:"varint,11,opt,name=canceled,proto3" json:"canceled,omitempty"`
+}
+
+func (m *AccountMigration) Reset()         { *m = AccountMigration{} }
+func (m *AccountMigration) String() string { return proto.CompactTextString(m) }
+func (*AccountMigration) ProtoMessage()    {}
+func (*AccountMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bedc825d28902e1b, []int{0}
+}
+func (m *AccountMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountMigration.Merge(m, src)
+}
+func (m *AccountMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountMigration proto.InternalMessageInfo
+
+func (m *AccountMigration) GetOldCodeIds() []uint64 {
+	if m != nil {
+		return m.OldCodeIds
+	}
+	return nil
+}
+
+func (m *AccountMigration) GetNewCodeId() uint64 {
+	if m != nil {
+		return m.NewCodeId
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetMigrateMsg() []byte {
+	if m != nil {
+		return m.MigrateMsg
+	}
+	return nil
+}
+
+func (m *AccountMigration) GetBatchSize() uint64 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetCodeIndex() uint32 {
+	if m != nil {
+		return m.CodeIndex
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *AccountMigration) GetMigrated() uint64 {
+	if m != nil {
+		return m.Migrated
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *AccountMigration) GetCanceled() bool {
+	if m != nil {
+		return m.Canceled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*AccountMigration)(nil), "xion.v1.AccountMigration")
+}
+
+func init() { proto.RegisterFile("xion/v1/migration.proto", fileDescriptor_bedc825d28902e1b) }
+
+var fileDescriptor_bedc825d28902e1b = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0xb1, 0x6e, 0xf2, 0x30,
+	0x14, 0x85, 0x31, 0xf0, 0x43, 0xe2, 0xf0, 0x4b, 0x95, 0x87, 0xd6, 0x42, 0x6a, 0x9a, 0x76, 0x69,
+	0x96, 0x12, 0xa1, 0x3e, 0x01, 0xed, 0x52, 0x06, 0x96, 0x74, 0xeb, 0x12, 0x25, 0xb6, 0x9b, 0x58,
+	0x0a, 0x36, 0x8a, 0x1d, 0xa0, 0x3c, 0x45, 0x1f, 0xab, 0x23, 0x63, 0x97, 0x4a, 0x15, 0xbc, 0x48,
+	0x65, 0x27, 0x30, 0x59, 0xe7, 0x3b, 0xe7, 0x9e, 0x6b, 0xe9, 0xc2, 0xab, 0x2d, 0x97, 0x22, 0x5a,
+	0x4f, 0xa3, 0x25, 0xcf, 0xab, 0x54, 0x73, 0x29, 0x26, 0xab, 0x4a, 0x6a, 0x89, 0x86, 0xc6, 0x98,
+	0xac, 0xa7, 0x77, 0x3f, 0x5d, 0x78, 0x31, 0x23, 0x44, 0xd6, 0x42, 0x2f, 0x4e, 0x19, 0x14, 0xc0,
+	0x91, 0x2c, 0x69, 0x42, 0x24, 0x65, 0x09, 0xa7, 0x0a, 0x83, 0xa0, 0x17, 0xf6, 0x63, 0x28, 0x4b,
+	0xfa, 0x2c, 0x29, 0x9b, 0x53, 0x85, 0x7c, 0xe8, 0x09, 0xb6, 0x39, 0x25, 0x70, 0x37, 0x00, 0x61,
+	0x3f, 0x76, 0x05, 0xdb, 0x34, 0x01, 0x74, 0x03, 0xbd, 0x66, 0x25, 0x4b, 0x96, 0x2a, 0xc7, 0xbd,
+	0x00, 0x84, 0xa3, 0x18, 0xb6, 0x68, 0xa1, 0x72, 0x74, 0x0d, 0x61, 0x96, 0x6a, 0x52, 0x24, 0x8a,
+	0xef, 0x18, 0xee, 0x37, 0xf3, 0x96, 0xbc, 0xf2, 0x1d, 0x33, 0x76, 0xd3, 0x2d, 0x28, 0xdb, 0xe2,
+	0x7f, 0x01, 0x08, 0xff, 0xc7, 0xae, 0x21, 0x73, 0x03, 0xd0, 0x25, 0x1c, 0x90, 0xba, 0x52, 0xb2,
+	0xc2, 0x03, 0xdb, 0xdc, 0x2a, 0x34, 0x86, 0x4e, 0xbb, 0x83, 0xe2, 0xa1, 0xed, 0x3c, 0x6b, 0x33,
+	0xf3, 0x9e, 0xf2, 0x92, 0x51, 0xec, 0x58, 0xa7, 0x55, 0xe8, 0x16, 0x8e, 0x94, 0x4e, 0x2b, 0x9d,
+	0x14, 0x8c, 0xe7, 0x85, 0xc6, 0x6e, 0x00, 0xc2, 0x5e, 0xec, 0x59, 0xf6, 0x62, 0x91, 0xf9, 0x0d,
+	0x13, 0xf4, 0x14, 0x80, 0x36, 0xe0, 0x32, 0x41, 0x5b, 0x7b, 0x0c, 0x1d, 0x92, 0x0a, 0xc2, 0x4c,
+	0xb7, 0x17, 0x80, 0xd0, 0x89, 0xcf, 0xfa, 0x69, 0xf6, 0x75, 0xf0, 0xc1, 0xfe, 0xe0, 0x83, 0xdf,
+	0x83, 0x0f, 0x3e, 0x8f, 0x7e, 0x67, 0x7f, 0xf4, 0x3b, 0xdf, 0x47, 0xbf, 0xf3, 0x76, 0x9f, 0x73,
+	0x5d, 0xd4, 0xd9, 0x84, 0xc8, 0x65, 0x94, 0xd5, 0x95, 0xd0, 0x0f, 0x65, 0x9a, 0xa9, 0xc8, 0x5e,
+	0x6c, 0xdb, 0x3c, 0xfa, 0x63, 0xc5, 0x54, 0x36, 0xb0, 0x27, 0x7b, 0xfc, 0x1b, 0x00, 0xd1, 0xe6,
+	0xb1, 0xcd, 0xcd, 0x01, 0x00, 0x00,
+}
+
+func (m *AccountMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Canceled {
+		i--
+		if m.Canceled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintMigration(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMigration(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Failed != 0 {
+		i = encodeVarintMigration(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Migrated != 0 {
+		i = encodeVarintMigration(dAtA, i, uint64(m.Migrated))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CodeIndex != 0 {
+		i = encodeVarintMigration(dAtA, i, uint64(m.CodeIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchSize != 0 {
+		i = encodeVarintMigration(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MigrateMsg) > 0 {
+		i -= len(m.MigrateMsg)
+		copy(dAtA[i:], m.MigrateMsg)
+		i = encodeVarintMigration(dAtA, i, uint64(len(m.MigrateMsg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewCodeId != 0 {
+		i = encodeVarintMigration(dAtA, i, uint64(m.NewCodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OldCodeIds) > 0 {
+		dAtA2 := make([]byte, len(m.OldCodeIds)*10)
+		var j1 int
+		for _, num := range m.OldCodeIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintMigration(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMigration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMigration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OldCodeIds) > 0 {
+		l = 0
+		for _, e := range m.OldCodeIds {
+			l += sovMigration(uint64(e))
+		}
+		n += 1 + sovMigration(uint64(l)) + l
+	}
+	if m.NewCodeId != 0 {
+		n += 1 + sovMigration(uint64(m.NewCodeId))
+	}
+	l = len(m.MigrateMsg)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovMigration(uint64(m.BatchSize))
+	}
+	if m.CodeIndex != 0 {
+		n += 1 + sovMigration(uint64(m.CodeIndex))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovMigration(uint64(l))
+	}
+	if m.Migrated != 0 {
+		n += 1 + sovMigration(uint64(m.Migrated))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovMigration(uint64(m.Failed))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMigration(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMigration(uint64(m.EndHeight))
+	}
+	if m.Canceled {
+		n += 2
+	}
+	return n
+}
+
+func sovMigration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMigration(x uint64) (n int) {
+	return sovMigration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMigration
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OldCodeIds = append(m.OldCodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMigration
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMigration
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMigration
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OldCodeIds) == 0 {
+					m.OldCodeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMigration
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OldCodeIds = append(m.OldCodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldCodeIds", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeId", wireType)
+			}
+			m.NewCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIndex", wireType)
+			}
+			m.CodeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMigration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrated", wireType)
+			}
+			m.Migrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Migrated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canceled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Canceled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMigration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMigration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMigration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMigration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMigration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMigration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMigration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMigration = fmt.Errorf("proto: unexpected end of group")
+)
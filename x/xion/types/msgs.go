@@ -15,12 +15,26 @@ const (
 	TypeMsgSend                  = "send"
 	TypeMsgMultiSend             = "multisend"
 	TypeMsgSetPlatformPercentage = "setplatformpercentage"
+
+	TypeMsgRegisterWebAuthNCredential = "registerwebauthncredential"
+	TypeMsgRemoveWebAuthNCredential   = "removewebauthncredential"
+
+	TypeMsgAuthenticateWebAuthNCredential = "authenticatewebauthncredential"
+	TypeMsgSetAttestationPolicy           = "setattestationpolicy"
+	TypeMsgMigrateAccounts                = "migrateaccounts"
+	TypeMsgCancelAccountMigration         = "cancelaccountmigration"
 )
 
 var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgSetPlatformPercentage{}
+	_ sdk.Msg = &MsgRegisterWebAuthNCredential{}
+	_ sdk.Msg = &MsgRemoveWebAuthNCredential{}
+	_ sdk.Msg = &MsgAuthenticateWebAuthNCredential{}
+	_ sdk.Msg = &MsgSetAttestationPolicy{}
+	_ sdk.Msg = &MsgMigrateAccounts{}
+	_ sdk.Msg = &MsgCancelAccountMigration{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -148,3 +162,231 @@ func (msg MsgSetPlatformPercentage) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRegisterWebAuthNCredential - construct a msg to register a webauthn credential.
+func NewMsgRegisterWebAuthNCredential(addr sdk.AccAddress, rp, challenge string, data []byte) *MsgRegisterWebAuthNCredential {
+	return &MsgRegisterWebAuthNCredential{Address: addr.String(), Rp: rp, Challenge: challenge, Data: data}
+}
+
+// Route Implements Msg
+func (msg MsgRegisterWebAuthNCredential) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRegisterWebAuthNCredential) Type() string { return TypeMsgRegisterWebAuthNCredential }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRegisterWebAuthNCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.Rp == "" && msg.RpId == "" {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, "relying party cannot be empty")
+	}
+
+	if _, err := NewRelyingParty(msg.Rp, msg.RpId, msg.Origins); err != nil {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, err.Error())
+	}
+
+	if msg.Challenge == "" {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, "challenge cannot be empty")
+	}
+
+	if len(msg.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, "registration data cannot be empty")
+	}
+
+	if msg.AttestationPolicy != nil {
+		if err := msg.AttestationPolicy.Validate(); err != nil {
+			return errorsmod.Wrap(ErrAttestationPolicy, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRegisterWebAuthNCredential) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRegisterWebAuthNCredential) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRemoveWebAuthNCredential - construct a msg to remove a webauthn credential.
+func NewMsgRemoveWebAuthNCredential(addr sdk.AccAddress, credentialID []byte) *MsgRemoveWebAuthNCredential {
+	return &MsgRemoveWebAuthNCredential{Address: addr.String(), CredentialId: credentialID}
+}
+
+// Route Implements Msg
+func (msg MsgRemoveWebAuthNCredential) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgRemoveWebAuthNCredential) Type() string { return TypeMsgRemoveWebAuthNCredential }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRemoveWebAuthNCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if len(msg.CredentialId) == 0 {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, "credential id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRemoveWebAuthNCredential) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRemoveWebAuthNCredential) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAuthenticateWebAuthNCredential - construct a msg to authenticate with a stored webauthn credential.
+func NewMsgAuthenticateWebAuthNCredential(addr sdk.AccAddress, rp, challenge string, data []byte) *MsgAuthenticateWebAuthNCredential {
+	return &MsgAuthenticateWebAuthNCredential{Address: addr.String(), Rp: rp, Challenge: challenge, Data: data}
+}
+
+// Route Implements Msg
+func (msg MsgAuthenticateWebAuthNCredential) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgAuthenticateWebAuthNCredential) Type() string {
+	return TypeMsgAuthenticateWebAuthNCredential
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAuthenticateWebAuthNCredential) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if msg.Rp == "" && msg.RpId == "" {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, "relying party cannot be empty")
+	}
+
+	if _, err := NewRelyingParty(msg.Rp, msg.RpId, msg.Origins); err != nil {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, err.Error())
+	}
+
+	if msg.Challenge == "" {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, "challenge cannot be empty")
+	}
+
+	if len(msg.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidWebAuthnData, "assertion data cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAuthenticateWebAuthNCredential) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAuthenticateWebAuthNCredential) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// Route Implements Msg
+func (msg MsgSetAttestationPolicy) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetAttestationPolicy) Type() string { return TypeMsgSetAttestationPolicy }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetAttestationPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return errorsmod.Wrap(ErrAttestationPolicy, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetAttestationPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetAttestationPolicy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// Route Implements Msg
+func (msg MsgMigrateAccounts) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgMigrateAccounts) Type() string { return TypeMsgMigrateAccounts }
+
+// ValidateBasic Implements Msg.
+func (msg MsgMigrateAccounts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if err := msg.AccountMigration(0).Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidAccountMigration, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgMigrateAccounts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgMigrateAccounts) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// AccountMigration returns the migration the message schedules at height
+func (msg MsgMigrateAccounts) AccountMigration(height int64) AccountMigration {
+	return NewAccountMigration(msg.OldCodeIds, msg.NewCodeId, msg.MigrateMsg, msg.BatchSize, height)
+}
+
+// Route Implements Msg
+func (msg MsgCancelAccountMigration) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgCancelAccountMigration) Type() string { return TypeMsgCancelAccountMigration }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelAccountMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelAccountMigration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelAccountMigration) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -32,6 +33,17 @@ type QueryWebAuthNVerifyRegisterRequest struct {
 	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
 	Rp        string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
 	Data      []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// rp_id is the relying party id the credential is scoped to, defaults to
+	// the host of rp
+	RpId string `protobuf:"bytes,5,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// origins are the additional origins allowed to act for rp_id
+	Origins []string `protobuf:"bytes,6,rep,name=origins,proto3" json:"origins,omitempty"`
+	// attestation_policy is an optional policy applied in addition to the
+	// module attestation policy
+	AttestationPolicy *AttestationPolicy `protobuf:"bytes,7,opt,name=attestation_policy,json=attestationPolicy,proto3" json:"attestation_policy,omitempty"`
+	// challenge_binding optionally requires the challenge to be derived from a
+	// transaction
+	ChallengeBinding *WebAuthnChallengeBinding `protobuf:"bytes,8,opt,name=challenge_binding,json=challengeBinding,proto3" json:"challenge_binding,omitempty"`
 }
 
 func (m *QueryWebAuthNVerifyRegisterRequest) Reset()         { *m = QueryWebAuthNVerifyRegisterRequest{} }
@@ -95,7 +107,39 @@ func (m *QueryWebAuthNVerifyRegisterRequest) GetData() []byte {
 	return nil
 }
 
+func (m *QueryWebAuthNVerifyRegisterRequest) GetRpId() string {
+	if m != nil {
+		return m.RpId
+	}
+	return ""
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) GetAttestationPolicy() *AttestationPolicy {
+	if m != nil {
+		return m.AttestationPolicy
+	}
+	return nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) GetChallengeBinding() *WebAuthnChallengeBinding {
+	if m != nil {
+		return m.ChallengeBinding
+	}
+	return nil
+}
+
 type QueryWebAuthNVerifyRegisterResponse struct {
+	// credential is the protobuf encoded WebAuthnCredential, the opaque value
+	// contracts store and pass back to verify assertions. It was the JSON
+	// encoding of the go-webauthn credential before, which is still accepted
+	// wherever a credential is passed back.
 	Credential []byte `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
 }
 
@@ -140,11 +184,27 @@ func (m *QueryWebAuthNVerifyRegisterResponse) GetCredential() []byte {
 }
 
 type QueryWebAuthNVerifyAuthenticateRequest struct {
-	Addr       string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Challenge  string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	Rp         string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	Addr      string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Rp        string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	// credential is a protobuf encoded WebAuthnCredential, or the legacy JSON
+	// encoding of the go-webauthn credential
 	Credential []byte `protobuf:"bytes,4,opt,name=credential,proto3" json:"credential,omitempty"`
 	Data       []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// rp_id is the relying party id the credential is scoped to, defaults to
+	// the host of rp
+	RpId string `protobuf:"bytes,6,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// origins are the additional origins allowed to act for rp_id
+	Origins []string `protobuf:"bytes,7,rep,name=origins,proto3" json:"origins,omitempty"`
+	// user_verification_required rejects assertions in which the
+	// authenticator did not verify the user
+	UserVerificationRequired bool `protobuf:"varint,8,opt,name=user_verification_required,json=userVerificationRequired,proto3" json:"user_verification_required,omitempty"`
+	// credentials are the candidate credentials of the account, the one
+	// matching the assertion's credential id is used. Exclusive with credential.
+	Credentials [][]byte `protobuf:"bytes,9,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	// challenge_binding optionally requires the challenge to be derived from a
+	// transaction
+	ChallengeBinding *WebAuthnChallengeBinding `protobuf:"bytes,10,opt,name=challenge_binding,json=challengeBinding,proto3" json:"challenge_binding,omitempty"`
 }
 
 func (m *QueryWebAuthNVerifyAuthenticateRequest) Reset() {
@@ -217,7 +277,54 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) GetData() []byte {
 	return nil
 }
 
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetRpId() string {
+	if m != nil {
+		return m.RpId
+	}
+	return ""
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
+	}
+	return nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetUserVerificationRequired() bool {
+	if m != nil {
+		return m.UserVerificationRequired
+	}
+	return false
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetCredentials() [][]byte {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) GetChallengeBinding() *WebAuthnChallengeBinding {
+	if m != nil {
+		return m.ChallengeBinding
+	}
+	return nil
+}
+
+// QueryWebAuthNVerifyAuthenticateResponse describes the verified assertion
 type QueryWebAuthNVerifyAuthenticateResponse struct {
+	// credential_id is the id of the credential that signed the assertion
+	CredentialId   []byte `protobuf:"bytes,1,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+	UserPresent    bool   `protobuf:"varint,2,opt,name=user_present,json=userPresent,proto3" json:"user_present,omitempty"`
+	UserVerified   bool   `protobuf:"varint,3,opt,name=user_verified,json=userVerified,proto3" json:"user_verified,omitempty"`
+	BackupEligible bool   `protobuf:"varint,4,opt,name=backup_eligible,json=backupEligible,proto3" json:"backup_eligible,omitempty"`
+	BackupState    bool   `protobuf:"varint,5,opt,name=backup_state,json=backupState,proto3" json:"backup_state,omitempty"`
+	// sign_count is the signature counter reported by the authenticator
+	SignCount uint32 `protobuf:"varint,6,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	// credential_index is the position of the matched credential in the
+	// request's credentials
+	CredentialIndex uint32 `protobuf:"varint,7,opt,name=credential_index,json=credentialIndex,proto3" json:"credential_index,omitempty"`
 }
 
 func (m *QueryWebAuthNVerifyAuthenticateResponse) Reset() {
@@ -255,413 +362,3674 @@ func (m *QueryWebAuthNVerifyAuthenticateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryWebAuthNVerifyAuthenticateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryWebAuthNVerifyRegisterRequest)(nil), "xion.v1.QueryWebAuthNVerifyRegisterRequest")
-	proto.RegisterType((*QueryWebAuthNVerifyRegisterResponse)(nil), "xion.v1.QueryWebAuthNVerifyRegisterResponse")
-	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateRequest)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateRequest")
-	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateResponse)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateResponse")
+func (m *QueryWebAuthNVerifyAuthenticateResponse) GetCredentialId() []byte {
+	if m != nil {
+		return m.CredentialId
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("xion/v1/query.proto", fileDescriptor_2d6eabf4b8b83bc3) }
-
-var fileDescriptor_2d6eabf4b8b83bc3 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xae, 0xc8, 0xcc, 0xcf,
-	0xd3, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x62, 0x07, 0x09, 0xea, 0x95, 0x19, 0x2a, 0x55, 0x71, 0x29, 0x05, 0x82, 0xc4, 0xc3, 0x53, 0x93,
-	0x1c, 0x4b, 0x4b, 0x32, 0xfc, 0xc2, 0x52, 0x8b, 0x32, 0xd3, 0x2a, 0x83, 0x52, 0xd3, 0x33, 0x8b,
-	0x4b, 0x52, 0x8b, 0x82, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x84, 0xb8, 0x58, 0x12, 0x53,
-	0x52, 0x8a, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xc0, 0x6c, 0x21, 0x19, 0x2e, 0xce, 0xe4,
-	0x8c, 0xc4, 0x9c, 0x9c, 0xd4, 0xbc, 0xf4, 0x54, 0x09, 0x26, 0xb0, 0x04, 0x42, 0x40, 0x88, 0x8f,
-	0x8b, 0xa9, 0xa8, 0x40, 0x82, 0x19, 0x2c, 0xcc, 0x54, 0x54, 0x00, 0x32, 0x21, 0x25, 0xb1, 0x24,
-	0x51, 0x82, 0x45, 0x81, 0x51, 0x83, 0x27, 0x08, 0xcc, 0x56, 0x72, 0xe5, 0x52, 0xc6, 0x6b, 0x77,
-	0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x1c, 0x17, 0x57, 0x72, 0x51, 0x6a, 0x4a, 0x6a, 0x5e,
-	0x49, 0x66, 0x62, 0x0e, 0xd8, 0x09, 0x3c, 0x41, 0x48, 0x22, 0x4a, 0xf3, 0x18, 0xb9, 0xd4, 0xb0,
-	0x98, 0x03, 0x62, 0x82, 0x54, 0x24, 0x27, 0x96, 0xa4, 0x52, 0xcf, 0x1f, 0xa8, 0x8e, 0x61, 0x41,
-	0x77, 0x0c, 0xdc, 0x9f, 0xac, 0x48, 0xfe, 0xd4, 0xe4, 0x52, 0x27, 0xe8, 0x3e, 0x88, 0x5f, 0x8d,
-	0x9a, 0x98, 0xb8, 0x58, 0xc1, 0x6a, 0x85, 0x4a, 0xb9, 0xc4, 0xb0, 0x87, 0x8b, 0x90, 0xb6, 0x1e,
-	0x34, 0xf2, 0xf4, 0x08, 0xc7, 0x9c, 0x94, 0x0e, 0x71, 0x8a, 0x21, 0xd6, 0x2b, 0x31, 0x08, 0x35,
-	0x32, 0x72, 0x49, 0xe1, 0x76, 0xa7, 0x90, 0x3e, 0x3e, 0xe3, 0xb0, 0x84, 0xb8, 0x94, 0x01, 0xf1,
-	0x1a, 0x60, 0x6e, 0x70, 0x72, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
-	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
-	0xf5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xa4, 0xd2, 0xa2, 0xbc,
-	0x12, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x7d, 0x70, 0x0a, 0xaf, 0x80, 0x50, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0xe0, 0x64, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xd9, 0x5e, 0xff,
-	0xfd, 0x02, 0x00, 0x00,
+func (m *QueryWebAuthNVerifyAuthenticateResponse) GetUserPresent() bool {
+	if m != nil {
+		return m.UserPresent
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error)
-	WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+func (m *QueryWebAuthNVerifyAuthenticateResponse) GetUserVerified() bool {
+	if m != nil {
+		return m.UserVerified
+	}
+	return false
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryWebAuthNVerifyAuthenticateResponse) GetBackupEligible() bool {
+	if m != nil {
+		return m.BackupEligible
+	}
+	return false
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryWebAuthNVerifyAuthenticateResponse) GetBackupState() bool {
+	if m != nil {
+		return m.BackupState
+	}
+	return false
 }
 
-func (c *queryClient) WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error) {
-	out := new(QueryWebAuthNVerifyRegisterResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyRegister", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWebAuthNVerifyAuthenticateResponse) GetSignCount() uint32 {
+	if m != nil {
+		return m.SignCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
-	out := new(QueryWebAuthNVerifyAuthenticateResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyAuthenticate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWebAuthNVerifyAuthenticateResponse) GetCredentialIndex() uint32 {
+	if m != nil {
+		return m.CredentialIndex
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	WebAuthNVerifyRegister(context.Context, *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error)
-	WebAuthNVerifyAuthenticate(context.Context, *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+// QueryWebAuthNVerifyAuthenticateStoredRequest verifies an assertion against
+// the stored credential matching the assertion's credential ID
+type QueryWebAuthNVerifyAuthenticateStoredRequest struct {
+	Addr      string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Rp        string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	Data      []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// rp_id is the relying party id the credential is scoped to, defaults to
+	// the host of rp
+	RpId string `protobuf:"bytes,5,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// origins are the additional origins allowed to act for rp_id
+	Origins []string `protobuf:"bytes,6,rep,name=origins,proto3" json:"origins,omitempty"`
+	// user_verification_required rejects assertions in which the
+	// authenticator did not verify the user
+	UserVerificationRequired bool `protobuf:"varint,7,opt,name=user_verification_required,json=userVerificationRequired,proto3" json:"user_verification_required,omitempty"`
+	// challenge_binding optionally requires the challenge to be derived from a
+	// transaction
+	ChallengeBinding *WebAuthnChallengeBinding `protobuf:"bytes,8,opt,name=challenge_binding,json=challengeBinding,proto3" json:"challenge_binding,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) Reset() {
+	*m = QueryWebAuthNVerifyAuthenticateStoredRequest{}
 }
-
-func (*UnimplementedQueryServer) WebAuthNVerifyRegister(ctx context.Context, req *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyRegister not implemented")
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) WebAuthNVerifyAuthenticate(ctx context.Context, req *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyAuthenticate not implemented")
+func (*QueryWebAuthNVerifyAuthenticateStoredRequest) ProtoMessage() {}
+func (*QueryWebAuthNVerifyAuthenticateStoredRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{4}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_WebAuthNVerifyRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWebAuthNVerifyRegisterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Query/WebAuthNVerifyRegister",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, req.(*QueryWebAuthNVerifyRegisterRequest))
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNVerifyAuthenticateStoredRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateStoredRequest.Merge(m, src)
+}
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNVerifyAuthenticateStoredRequest.DiscardUnknown(m)
 }
 
-func _Query_WebAuthNVerifyAuthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWebAuthNVerifyAuthenticateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Query/WebAuthNVerifyAuthenticate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, req.(*QueryWebAuthNVerifyAuthenticateRequest))
+var xxx_messageInfo_QueryWebAuthNVerifyAuthenticateStoredRequest proto.InternalMessageInfo
+
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xion.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "WebAuthNVerifyRegister",
-			Handler:    _Query_WebAuthNVerifyRegister_Handler,
-		},
-		{
-			MethodName: "WebAuthNVerifyAuthenticate",
-			Handler:    _Query_WebAuthNVerifyAuthenticate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "xion/v1/query.proto",
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
 }
 
-func (m *QueryWebAuthNVerifyRegisterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetRp() string {
+	if m != nil {
+		return m.Rp
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryWebAuthNVerifyRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyRegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Rp) > 0 {
-		i -= len(m.Rp)
-		copy(dAtA[i:], m.Rp)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
-		i--
-		dAtA[i] = 0x1a
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetRpId() string {
+	if m != nil {
+		return m.RpId
 	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetUserVerificationRequired() bool {
+	if m != nil {
+		return m.UserVerificationRequired
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryWebAuthNVerifyRegisterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) GetChallengeBinding() *WebAuthnChallengeBinding {
+	if m != nil {
+		return m.ChallengeBinding
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWebAuthNCredentialRequest struct {
+	Addr         string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	CredentialId []byte `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
 }
 
-func (m *QueryWebAuthNVerifyRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Credential) > 0 {
-		i -= len(m.Credential)
-		copy(dAtA[i:], m.Credential)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryWebAuthNCredentialRequest) Reset()         { *m = QueryWebAuthNCredentialRequest{} }
+func (m *QueryWebAuthNCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialRequest) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{5}
+}
+func (m *QueryWebAuthNCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryWebAuthNCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialRequest.Merge(m, src)
+}
+func (m *QueryWebAuthNCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWebAuthNCredentialRequest proto.InternalMessageInfo
+
+func (m *QueryWebAuthNCredentialRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWebAuthNCredentialRequest) GetCredentialId() []byte {
+	if m != nil {
+		return m.CredentialId
+	}
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x2a
+type QueryWebAuthNCredentialResponse struct {
+	Credential *WebAuthnCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+}
+
+func (m *QueryWebAuthNCredentialResponse) Reset()         { *m = QueryWebAuthNCredentialResponse{} }
+func (m *QueryWebAuthNCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialResponse) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{6}
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Credential) > 0 {
-		i -= len(m.Credential)
-		copy(dAtA[i:], m.Credential)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialResponse.Merge(m, src)
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWebAuthNCredentialResponse proto.InternalMessageInfo
+
+func (m *QueryWebAuthNCredentialResponse) GetCredential() *WebAuthnCredential {
+	if m != nil {
+		return m.Credential
 	}
-	if len(m.Rp) > 0 {
-		i -= len(m.Rp)
-		copy(dAtA[i:], m.Rp)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+type QueryWebAuthNCredentialsRequest struct {
+	Addr       string             `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWebAuthNCredentialsRequest) Reset()         { *m = QueryWebAuthNCredentialsRequest{} }
+func (m *QueryWebAuthNCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialsRequest) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{7}
+}
+func (m *QueryWebAuthNCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNCredentialsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Challenge) > 0 {
-		i -= len(m.Challenge)
-		copy(dAtA[i:], m.Challenge)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryWebAuthNCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialsRequest.Merge(m, src)
+}
+func (m *QueryWebAuthNCredentialsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWebAuthNCredentialsRequest proto.InternalMessageInfo
+
+func (m *QueryWebAuthNCredentialsRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryWebAuthNCredentialsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type QueryWebAuthNCredentialsResponse struct {
+	Credentials []*WebAuthnCredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	Pagination  *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWebAuthNCredentialsResponse) Reset()         { *m = QueryWebAuthNCredentialsResponse{} }
+func (m *QueryWebAuthNCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNCredentialsResponse) ProtoMessage()    {}
+func (*QueryWebAuthNCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{8}
+}
+func (m *QueryWebAuthNCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNCredentialsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryWebAuthNCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNCredentialsResponse.Merge(m, src)
+}
+func (m *QueryWebAuthNCredentialsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNCredentialsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryWebAuthNCredentialsResponse proto.InternalMessageInfo
+
+func (m *QueryWebAuthNCredentialsResponse) GetCredentials() []*WebAuthnCredential {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
 }
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryWebAuthNCredentialsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+type QueryAttestationPolicyRequest struct {
+}
+
+func (m *QueryAttestationPolicyRequest) Reset()         { *m = QueryAttestationPolicyRequest{} }
+func (m *QueryAttestationPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationPolicyRequest) ProtoMessage()    {}
+func (*QueryAttestationPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{9}
+}
+func (m *QueryAttestationPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryWebAuthNVerifyRegisterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttestationPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationPolicyRequest.Merge(m, src)
+}
+func (m *QueryAttestationPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationPolicyRequest proto.InternalMessageInfo
+
+type QueryAttestationPolicyResponse struct {
+	Policy *AttestationPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *QueryAttestationPolicyResponse) Reset()         { *m = QueryAttestationPolicyResponse{} }
+func (m *QueryAttestationPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationPolicyResponse) ProtoMessage()    {}
+func (*QueryAttestationPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{10}
+}
+func (m *QueryAttestationPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAttestationPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationPolicyResponse.Merge(m, src)
+}
+func (m *QueryAttestationPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationPolicyResponse) GetPolicy() *AttestationPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryWebAuthNChallengeRequest derives the challenge binding a WebAuthn
+// signature to the next transaction of addr
+type QueryWebAuthNChallengeRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// sign_doc_hash is the SHA-256 hash of the transaction's sign bytes
+	SignDocHash []byte `protobuf:"bytes,2,opt,name=sign_doc_hash,json=signDocHash,proto3" json:"sign_doc_hash,omitempty"`
+}
+
+func (m *QueryWebAuthNChallengeRequest) Reset()         { *m = QueryWebAuthNChallengeRequest{} }
+func (m *QueryWebAuthNChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNChallengeRequest) ProtoMessage()    {}
+func (*QueryWebAuthNChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{11}
+}
+func (m *QueryWebAuthNChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Rp)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryWebAuthNChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNChallengeRequest.Merge(m, src)
+}
+func (m *QueryWebAuthNChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNChallengeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWebAuthNChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryWebAuthNChallengeRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *QueryWebAuthNChallengeRequest) GetSignDocHash() []byte {
+	if m != nil {
+		return m.SignDocHash
+	}
+	return nil
+}
+
+type QueryWebAuthNChallengeResponse struct {
+	// challenge is the base64url encoded challenge
+	Challenge     string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	ChainId       string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Sequence      uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryWebAuthNChallengeResponse) Reset()         { *m = QueryWebAuthNChallengeResponse{} }
+func (m *QueryWebAuthNChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWebAuthNChallengeResponse) ProtoMessage()    {}
+func (*QueryWebAuthNChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{12}
+}
+func (m *QueryWebAuthNChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWebAuthNChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWebAuthNChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWebAuthNChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWebAuthNChallengeResponse.Merge(m, src)
+}
+func (m *QueryWebAuthNChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWebAuthNChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWebAuthNChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWebAuthNChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryWebAuthNChallengeResponse) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *QueryWebAuthNChallengeResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryWebAuthNChallengeResponse) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *QueryWebAuthNChallengeResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type QueryAccountMigrationRequest struct {
+}
+
+func (m *QueryAccountMigrationRequest) Reset()         { *m = QueryAccountMigrationRequest{} }
+func (m *QueryAccountMigrationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountMigrationRequest) ProtoMessage()    {}
+func (*QueryAccountMigrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{13}
+}
+func (m *QueryAccountMigrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountMigrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountMigrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountMigrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountMigrationRequest.Merge(m, src)
+}
+func (m *QueryAccountMigrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountMigrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountMigrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountMigrationRequest proto.InternalMessageInfo
+
+// QueryAccountMigrationResponse reports the progress of the latest abstract
+// account migration
+type QueryAccountMigrationResponse struct {
+	Migration *AccountMigration `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"`
+	// remaining is the number of contracts of the old code ids still to be
+	// processed, including the ones that failed to migrate
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *QueryAccountMigrationResponse) Reset()         { *m = QueryAccountMigrationResponse{} }
+func (m *QueryAccountMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountMigrationResponse) ProtoMessage()    {}
+func (*QueryAccountMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6eabf4b8b83bc3, []int{14}
+}
+func (m *QueryAccountMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountMigrationResponse.Merge(m, src)
+}
+func (m *QueryAccountMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountMigrationResponse proto.InternalMessageInfo
+
+func (m *QueryAccountMigrationResponse) GetMigration() *AccountMigration {
+	if m != nil {
+		return m.Migration
+	}
+	return nil
+}
+
+func (m *QueryAccountMigrationResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryWebAuthNVerifyRegisterRequest)(nil), "xion.v1.QueryWebAuthNVerifyRegisterRequest")
+	proto.RegisterType((*QueryWebAuthNVerifyRegisterResponse)(nil), "xion.v1.QueryWebAuthNVerifyRegisterResponse")
+	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateRequest)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateRequest")
+	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateResponse)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateResponse")
+	proto.RegisterType((*QueryWebAuthNVerifyAuthenticateStoredRequest)(nil), "xion.v1.QueryWebAuthNVerifyAuthenticateStoredRequest")
+	proto.RegisterType((*QueryWebAuthNCredentialRequest)(nil), "xion.v1.QueryWebAuthNCredentialRequest")
+	proto.RegisterType((*QueryWebAuthNCredentialResponse)(nil), "xion.v1.QueryWebAuthNCredentialResponse")
+	proto.RegisterType((*QueryWebAuthNCredentialsRequest)(nil), "xion.v1.QueryWebAuthNCredentialsRequest")
+	proto.RegisterType((*QueryWebAuthNCredentialsResponse)(nil), "xion.v1.QueryWebAuthNCredentialsResponse")
+	proto.RegisterType((*QueryAttestationPolicyRequest)(nil), "xion.v1.QueryAttestationPolicyRequest")
+	proto.RegisterType((*QueryAttestationPolicyResponse)(nil), "xion.v1.QueryAttestationPolicyResponse")
+	proto.RegisterType((*QueryWebAuthNChallengeRequest)(nil), "xion.v1.QueryWebAuthNChallengeRequest")
+	proto.RegisterType((*QueryWebAuthNChallengeResponse)(nil), "xion.v1.QueryWebAuthNChallengeResponse")
+	proto.RegisterType((*QueryAccountMigrationRequest)(nil), "xion.v1.QueryAccountMigrationRequest")
+	proto.RegisterType((*QueryAccountMigrationResponse)(nil), "xion.v1.QueryAccountMigrationResponse")
+}
+
+func init() { proto.RegisterFile("xion/v1/query.proto", fileDescriptor_2d6eabf4b8b83bc3) }
+
+var fileDescriptor_2d6eabf4b8b83bc3 = []byte{
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x3a, 0x4e, 0x6c, 0xbf, 0x76, 0xd2, 0x64, 0x22, 0x95, 0xed, 0xd2, 0x6c, 0xdd, 0xad,
+	0x1a, 0xbb, 0x50, 0x6c, 0x12, 0x84, 0x38, 0x00, 0x87, 0x34, 0x7c, 0x05, 0x44, 0x14, 0xa6, 0xa8,
+	0x15, 0x1c, 0xb0, 0x66, 0x77, 0xa7, 0xf6, 0x08, 0x67, 0x77, 0x33, 0xb3, 0x1b, 0x92, 0x03, 0x07,
+	0x7e, 0x00, 0x12, 0x3f, 0x80, 0x03, 0x67, 0xc4, 0x9f, 0x40, 0xe2, 0xc0, 0xb1, 0x47, 0x8e, 0x28,
+	0xf9, 0x1d, 0x48, 0x68, 0x66, 0xbf, 0xfc, 0xbd, 0x51, 0xd5, 0x43, 0x4f, 0xde, 0x7d, 0xe6, 0x99,
+	0xf7, 0xe3, 0x79, 0x67, 0xde, 0x77, 0x0d, 0x5b, 0xe7, 0xcc, 0xf7, 0xba, 0x67, 0xbb, 0xdd, 0xd3,
+	0x88, 0xf2, 0x8b, 0x4e, 0xc0, 0xfd, 0xd0, 0x47, 0x15, 0x09, 0x76, 0xce, 0x76, 0x8d, 0x37, 0x1c,
+	0x5f, 0x9c, 0xf8, 0xa2, 0x6b, 0x13, 0x41, 0x63, 0x46, 0xf7, 0x6c, 0xd7, 0xa6, 0x21, 0xd9, 0xed,
+	0x06, 0xa4, 0xcf, 0x3c, 0x12, 0x4a, 0xa2, 0xda, 0x64, 0xbc, 0x96, 0x5a, 0x3a, 0x61, 0x7d, 0x3e,
+	0xba, 0x70, 0x33, 0x5d, 0xf8, 0x81, 0xda, 0x24, 0x0a, 0x07, 0x09, 0x6e, 0xfd, 0x55, 0x02, 0xeb,
+	0x2b, 0x69, 0xf3, 0x29, 0xb5, 0xf7, 0xa3, 0x70, 0x70, 0xf4, 0x84, 0x72, 0xf6, 0xec, 0x02, 0xd3,
+	0x3e, 0x13, 0x21, 0xe5, 0x98, 0x9e, 0x46, 0x54, 0x84, 0x08, 0x41, 0x99, 0xb8, 0x2e, 0xd7, 0xb5,
+	0xa6, 0xd6, 0xae, 0x61, 0xf5, 0x8c, 0x6e, 0x43, 0xcd, 0x19, 0x90, 0xe1, 0x90, 0x7a, 0x7d, 0xaa,
+	0x97, 0xd4, 0x42, 0x0e, 0xa0, 0x75, 0x28, 0xf1, 0x40, 0x5f, 0x56, 0x70, 0x89, 0x07, 0xd2, 0x82,
+	0x4b, 0x42, 0xa2, 0x97, 0x9b, 0x5a, 0xbb, 0x81, 0xd5, 0x33, 0xda, 0x82, 0x15, 0x1e, 0xf4, 0x98,
+	0xab, 0xaf, 0xc4, 0x66, 0x79, 0x70, 0xe8, 0x22, 0x1d, 0x2a, 0x3e, 0x67, 0x7d, 0xe6, 0x09, 0x7d,
+	0xb5, 0xb9, 0xdc, 0xae, 0xe1, 0xf4, 0x15, 0x1d, 0x02, 0x22, 0x61, 0x48, 0x45, 0xa8, 0x12, 0xeb,
+	0x05, 0xfe, 0x90, 0x39, 0x17, 0x7a, 0xa5, 0xa9, 0xb5, 0xeb, 0x7b, 0x46, 0x27, 0x91, 0xab, 0xb3,
+	0x9f, 0x53, 0x8e, 0x15, 0x03, 0x6f, 0x92, 0x49, 0x08, 0x1d, 0xc1, 0x66, 0x16, 0x6a, 0xcf, 0x66,
+	0x9e, 0xcb, 0xbc, 0xbe, 0x5e, 0x55, 0x96, 0xee, 0x66, 0x96, 0x12, 0x49, 0xbc, 0x83, 0x94, 0xf9,
+	0x28, 0x26, 0xe2, 0x0d, 0x67, 0x02, 0xb1, 0xbe, 0x80, 0x7b, 0x0b, 0x55, 0x14, 0x81, 0xef, 0x09,
+	0x8a, 0x4c, 0x00, 0x87, 0x53, 0x97, 0x7a, 0x21, 0x23, 0x43, 0x25, 0x66, 0x03, 0x8f, 0x20, 0x9f,
+	0x97, 0xab, 0xa5, 0x8d, 0x65, 0xeb, 0xbf, 0x12, 0xec, 0xcc, 0xb0, 0x26, 0x1f, 0x25, 0xcf, 0x21,
+	0x21, 0x7d, 0x79, 0x75, 0x19, 0x0f, 0xa9, 0x3c, 0x19, 0x52, 0x56, 0xb7, 0x95, 0x59, 0x75, 0x5b,
+	0x9d, 0x5d, 0xb7, 0xca, 0x78, 0xdd, 0x3e, 0x00, 0x23, 0x12, 0x94, 0xf7, 0xce, 0x64, 0x1a, 0xcc,
+	0x89, 0xab, 0xc7, 0xe9, 0x69, 0xc4, 0x38, 0x75, 0x95, 0xea, 0x55, 0xac, 0x4b, 0xc6, 0x93, 0x11,
+	0x02, 0x4e, 0xd6, 0x51, 0x13, 0xea, 0x79, 0x38, 0x42, 0xaf, 0x35, 0x97, 0xdb, 0x0d, 0x3c, 0x0a,
+	0xcd, 0x2e, 0x26, 0xbc, 0x78, 0x31, 0xff, 0x28, 0x41, 0xab, 0x50, 0xff, 0xa4, 0xa2, 0xf7, 0x60,
+	0x2d, 0x0f, 0x45, 0x4a, 0x12, 0x17, 0xb5, 0x91, 0x83, 0x87, 0x2e, 0xba, 0x0b, 0x0d, 0x25, 0x40,
+	0xc0, 0xa9, 0xa0, 0x5e, 0xa8, 0x8a, 0x52, 0xc5, 0x75, 0x89, 0x1d, 0xc7, 0x90, 0xb4, 0x33, 0xa2,
+	0x11, 0x75, 0x55, 0x85, 0xaa, 0xb8, 0x91, 0xcb, 0x42, 0x5d, 0xd4, 0x82, 0x1b, 0x36, 0x71, 0xbe,
+	0x8f, 0x82, 0x1e, 0x1d, 0xb2, 0x3e, 0xb3, 0x87, 0x54, 0x15, 0xac, 0x8a, 0xd7, 0x63, 0xf8, 0xe3,
+	0x04, 0x95, 0x0e, 0x13, 0xa2, 0x3c, 0xf6, 0x54, 0x15, 0xaf, 0x8a, 0xeb, 0x31, 0xf6, 0x58, 0x42,
+	0x68, 0x1b, 0x40, 0xb0, 0xbe, 0xd7, 0x73, 0xfc, 0xc8, 0x0b, 0x55, 0x21, 0xd7, 0x70, 0x4d, 0x22,
+	0x07, 0x12, 0x40, 0x0f, 0x60, 0x63, 0x34, 0x2f, 0xcf, 0xa5, 0xe7, 0xea, 0xa6, 0xad, 0xe1, 0x1b,
+	0x23, 0xa9, 0x49, 0xd8, 0xfa, 0xb3, 0x04, 0x0f, 0x0b, 0xe4, 0x7a, 0x1c, 0xfa, 0x9c, 0xba, 0xaf,
+	0x54, 0x33, 0x59, 0x7c, 0x28, 0x2b, 0x05, 0x87, 0xf2, 0x65, 0xf7, 0x8f, 0x6f, 0xc0, 0x1c, 0x93,
+	0xf0, 0x20, 0xd3, 0x78, 0x91, 0x68, 0x53, 0x87, 0xaf, 0x34, 0x7d, 0xf8, 0xac, 0xef, 0xe0, 0xce,
+	0x5c, 0xd3, 0xc9, 0x21, 0x7e, 0x7f, 0xaa, 0x2d, 0xd5, 0xf7, 0x5e, 0x9f, 0x4e, 0x23, 0xdf, 0x38,
+	0x42, 0xb7, 0x7e, 0x9c, 0x6b, 0x5f, 0x2c, 0x8a, 0xfd, 0x13, 0x80, 0x7c, 0x7a, 0xa9, 0xc0, 0xeb,
+	0x7b, 0x3b, 0x9d, 0x78, 0xd4, 0x75, 0xe4, 0xa8, 0xeb, 0xc4, 0xc3, 0x30, 0x19, 0x75, 0x9d, 0x63,
+	0xd2, 0x4f, 0xbb, 0x1e, 0x1e, 0xd9, 0x69, 0xfd, 0xae, 0x41, 0x73, 0xbe, 0xff, 0x24, 0xc1, 0x0f,
+	0xc7, 0x7b, 0x88, 0xd6, 0x5c, 0x2e, 0xca, 0x70, 0xac, 0xc1, 0x7c, 0x3a, 0x23, 0xd6, 0x56, 0x61,
+	0xac, 0xb1, 0xef, 0xb1, 0x60, 0xef, 0xc0, 0xb6, 0x8a, 0x75, 0x7a, 0x46, 0xc5, 0x99, 0x59, 0x5f,
+	0x83, 0x39, 0x8f, 0x90, 0xa4, 0xb2, 0x07, 0xab, 0xc9, 0xe0, 0xd3, 0x0a, 0x07, 0x5f, 0xc2, 0xb4,
+	0x9e, 0x26, 0x6e, 0x33, 0x89, 0xd2, 0xe3, 0xb7, 0xa8, 0x40, 0x16, 0xac, 0xa9, 0x06, 0xe1, 0xfa,
+	0x4e, 0x6f, 0x40, 0xc4, 0x20, 0x39, 0x5c, 0x75, 0x09, 0x7e, 0xe4, 0x3b, 0x9f, 0x11, 0x31, 0xb0,
+	0x7e, 0xd5, 0xc0, 0x9c, 0x67, 0x39, 0x89, 0x77, 0xec, 0x62, 0x6b, 0x93, 0x17, 0xfb, 0x16, 0x54,
+	0x9d, 0x01, 0x61, 0x5e, 0x7a, 0x78, 0x6b, 0xb8, 0xa2, 0xde, 0x0f, 0x5d, 0x74, 0x1f, 0xd6, 0x89,
+	0xa3, 0xba, 0x53, 0xcf, 0x8b, 0x4e, 0x6c, 0xca, 0xd5, 0xfd, 0x2f, 0xe3, 0xb5, 0x04, 0x3d, 0x52,
+	0x20, 0x32, 0xa0, 0x2a, 0x64, 0x16, 0x9e, 0x13, 0x37, 0xc3, 0x32, 0xce, 0xde, 0x2d, 0x13, 0x6e,
+	0xc7, 0x6a, 0xc6, 0x3b, 0xbe, 0x4c, 0xbf, 0x89, 0x52, 0xb5, 0xcf, 0x60, 0x7b, 0xce, 0x7a, 0x12,
+	0xfc, 0x7b, 0x50, 0xcb, 0x3e, 0xa4, 0x12, 0xbd, 0x6f, 0xe5, 0x7a, 0x4f, 0xee, 0xca, 0xb9, 0x32,
+	0x6b, 0x4e, 0x4f, 0x08, 0xf3, 0x64, 0x5f, 0x28, 0xa9, 0xb0, 0x72, 0x60, 0xef, 0xb7, 0x0a, 0xac,
+	0x28, 0xc7, 0x28, 0x82, 0x9b, 0xb3, 0x3f, 0x19, 0xd0, 0x9b, 0x99, 0x9f, 0xe2, 0xcf, 0x33, 0xe3,
+	0xe1, 0xf5, 0xc8, 0x71, 0x56, 0xd6, 0x12, 0xfa, 0x49, 0x03, 0x63, 0x7e, 0xb7, 0x46, 0xdd, 0x45,
+	0xe6, 0x66, 0x7c, 0x86, 0x18, 0x6f, 0x5f, 0x7f, 0x43, 0x16, 0xc3, 0xcf, 0x1a, 0x34, 0x8b, 0x26,
+	0x06, 0x7a, 0xf7, 0xba, 0x86, 0xc7, 0x26, 0xcc, 0x0b, 0xc5, 0xc3, 0x00, 0x4d, 0xb7, 0x10, 0xd4,
+	0x9a, 0x6d, 0x69, 0xaa, 0x3f, 0x1b, 0xed, 0x62, 0x62, 0xe6, 0x6a, 0x08, 0x5b, 0xd3, 0xeb, 0x02,
+	0x15, 0x9a, 0x48, 0x1b, 0xaa, 0xf1, 0xe0, 0x1a, 0xcc, 0xcc, 0xdb, 0x33, 0xd8, 0x9c, 0x6a, 0x0d,
+	0x68, 0x67, 0xdc, 0xc2, 0xbc, 0x86, 0x64, 0xb4, 0x0a, 0x79, 0xa3, 0x7e, 0xa6, 0xda, 0xc0, 0xa4,
+	0x9f, 0x79, 0x1d, 0xc8, 0x68, 0x15, 0xf2, 0x32, 0x3f, 0x0e, 0x6c, 0x4c, 0x5e, 0x3d, 0x74, 0x7f,
+	0x22, 0xcc, 0xd9, 0x17, 0xde, 0xd8, 0x29, 0xa2, 0xa5, 0x4e, 0x1e, 0xed, 0xff, 0x7d, 0x69, 0x6a,
+	0xcf, 0x2f, 0x4d, 0xed, 0xdf, 0x4b, 0x53, 0xfb, 0xe5, 0xca, 0x5c, 0x7a, 0x7e, 0x65, 0x2e, 0xfd,
+	0x73, 0x65, 0x2e, 0x7d, 0xdb, 0xea, 0xb3, 0x70, 0x10, 0xd9, 0x1d, 0xc7, 0x3f, 0xe9, 0xda, 0x11,
+	0xf7, 0xc2, 0xb7, 0x86, 0xc4, 0x16, 0x5d, 0xf5, 0xff, 0xea, 0x3c, 0xfe, 0x09, 0x2f, 0x02, 0x2a,
+	0xec, 0x55, 0xf5, 0x0f, 0xeb, 0x9d, 0xff, 0x07, 0x00, 0xa9, 0xf3, 0xfb, 0x10, 0xde, 0x0d, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error)
+	WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	WebAuthNVerifyAuthenticateStored(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateStoredRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	WebAuthNCredential(ctx context.Context, in *QueryWebAuthNCredentialRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialResponse, error)
+	WebAuthNCredentials(ctx context.Context, in *QueryWebAuthNCredentialsRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialsResponse, error)
+	AttestationPolicy(ctx context.Context, in *QueryAttestationPolicyRequest, opts ...grpc.CallOption) (*QueryAttestationPolicyResponse, error)
+	WebAuthNChallenge(ctx context.Context, in *QueryWebAuthNChallengeRequest, opts ...grpc.CallOption) (*QueryWebAuthNChallengeResponse, error)
+	AccountMigration(ctx context.Context, in *QueryAccountMigrationRequest, opts ...grpc.CallOption) (*QueryAccountMigrationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WebAuthNVerifyRegister(ctx context.Context, in *QueryWebAuthNVerifyRegisterRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyRegisterResponse, error) {
+	out := new(QueryWebAuthNVerifyRegisterResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyRegister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNVerifyAuthenticate(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
+	out := new(QueryWebAuthNVerifyAuthenticateResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyAuthenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNVerifyAuthenticateStored(ctx context.Context, in *QueryWebAuthNVerifyAuthenticateStoredRequest, opts ...grpc.CallOption) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
+	out := new(QueryWebAuthNVerifyAuthenticateResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNVerifyAuthenticateStored", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNCredential(ctx context.Context, in *QueryWebAuthNCredentialRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialResponse, error) {
+	out := new(QueryWebAuthNCredentialResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNCredentials(ctx context.Context, in *QueryWebAuthNCredentialsRequest, opts ...grpc.CallOption) (*QueryWebAuthNCredentialsResponse, error) {
+	out := new(QueryWebAuthNCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationPolicy(ctx context.Context, in *QueryAttestationPolicyRequest, opts ...grpc.CallOption) (*QueryAttestationPolicyResponse, error) {
+	out := new(QueryAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/AttestationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WebAuthNChallenge(ctx context.Context, in *QueryWebAuthNChallengeRequest, opts ...grpc.CallOption) (*QueryWebAuthNChallengeResponse, error) {
+	out := new(QueryWebAuthNChallengeResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/WebAuthNChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountMigration(ctx context.Context, in *QueryAccountMigrationRequest, opts ...grpc.CallOption) (*QueryAccountMigrationResponse, error) {
+	out := new(QueryAccountMigrationResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Query/AccountMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	WebAuthNVerifyRegister(context.Context, *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error)
+	WebAuthNVerifyAuthenticate(context.Context, *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	WebAuthNVerifyAuthenticateStored(context.Context, *QueryWebAuthNVerifyAuthenticateStoredRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error)
+	WebAuthNCredential(context.Context, *QueryWebAuthNCredentialRequest) (*QueryWebAuthNCredentialResponse, error)
+	WebAuthNCredentials(context.Context, *QueryWebAuthNCredentialsRequest) (*QueryWebAuthNCredentialsResponse, error)
+	AttestationPolicy(context.Context, *QueryAttestationPolicyRequest) (*QueryAttestationPolicyResponse, error)
+	WebAuthNChallenge(context.Context, *QueryWebAuthNChallengeRequest) (*QueryWebAuthNChallengeResponse, error)
+	AccountMigration(context.Context, *QueryAccountMigrationRequest) (*QueryAccountMigrationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WebAuthNVerifyRegister(ctx context.Context, req *QueryWebAuthNVerifyRegisterRequest) (*QueryWebAuthNVerifyRegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyRegister not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNVerifyAuthenticate(ctx context.Context, req *QueryWebAuthNVerifyAuthenticateRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyAuthenticate not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNVerifyAuthenticateStored(ctx context.Context, req *QueryWebAuthNVerifyAuthenticateStoredRequest) (*QueryWebAuthNVerifyAuthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNVerifyAuthenticateStored not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNCredential(ctx context.Context, req *QueryWebAuthNCredentialRequest) (*QueryWebAuthNCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNCredential not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNCredentials(ctx context.Context, req *QueryWebAuthNCredentialsRequest) (*QueryWebAuthNCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNCredentials not implemented")
+}
+func (*UnimplementedQueryServer) AttestationPolicy(ctx context.Context, req *QueryAttestationPolicyRequest) (*QueryAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationPolicy not implemented")
+}
+func (*UnimplementedQueryServer) WebAuthNChallenge(ctx context.Context, req *QueryWebAuthNChallengeRequest) (*QueryWebAuthNChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebAuthNChallenge not implemented")
+}
+func (*UnimplementedQueryServer) AccountMigration(ctx context.Context, req *QueryAccountMigrationRequest) (*QueryAccountMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountMigration not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WebAuthNVerifyRegister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNVerifyRegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNVerifyRegister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNVerifyRegister(ctx, req.(*QueryWebAuthNVerifyRegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNVerifyAuthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNVerifyAuthenticateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNVerifyAuthenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNVerifyAuthenticate(ctx, req.(*QueryWebAuthNVerifyAuthenticateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNVerifyAuthenticateStored_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNVerifyAuthenticateStoredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNVerifyAuthenticateStored(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNVerifyAuthenticateStored",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNVerifyAuthenticateStored(ctx, req.(*QueryWebAuthNVerifyAuthenticateStoredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNCredential(ctx, req.(*QueryWebAuthNCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNCredentials(ctx, req.(*QueryWebAuthNCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/AttestationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationPolicy(ctx, req.(*QueryAttestationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WebAuthNChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWebAuthNChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WebAuthNChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/WebAuthNChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WebAuthNChallenge(ctx, req.(*QueryWebAuthNChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Query/AccountMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountMigration(ctx, req.(*QueryAccountMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xion.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WebAuthNVerifyRegister",
+			Handler:    _Query_WebAuthNVerifyRegister_Handler,
+		},
+		{
+			MethodName: "WebAuthNVerifyAuthenticate",
+			Handler:    _Query_WebAuthNVerifyAuthenticate_Handler,
+		},
+		{
+			MethodName: "WebAuthNVerifyAuthenticateStored",
+			Handler:    _Query_WebAuthNVerifyAuthenticateStored_Handler,
+		},
+		{
+			MethodName: "WebAuthNCredential",
+			Handler:    _Query_WebAuthNCredential_Handler,
+		},
+		{
+			MethodName: "WebAuthNCredentials",
+			Handler:    _Query_WebAuthNCredentials_Handler,
+		},
+		{
+			MethodName: "AttestationPolicy",
+			Handler:    _Query_AttestationPolicy_Handler,
+		},
+		{
+			MethodName: "WebAuthNChallenge",
+			Handler:    _Query_WebAuthNChallenge_Handler,
+		},
+		{
+			MethodName: "AccountMigration",
+			Handler:    _Query_AccountMigration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xion/v1/query.proto",
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyRegisterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeBinding != nil {
+		{
+			size, err := m.ChallengeBinding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AttestationPolicy != nil {
+		{
+			size, err := m.AttestationPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Origins[iNdEx])
+			copy(dAtA[i:], m.Origins[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Origins[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RpId) > 0 {
+		i -= len(m.RpId)
+		copy(dAtA[i:], m.RpId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RpId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeBinding != nil {
+		{
+			size, err := m.ChallengeBinding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Credentials[iNdEx])
+			copy(dAtA[i:], m.Credentials[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Credentials[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.UserVerificationRequired {
+		i--
+		if m.UserVerificationRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Origins[iNdEx])
+			copy(dAtA[i:], m.Origins[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Origins[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RpId) > 0 {
+		i -= len(m.RpId)
+		copy(dAtA[i:], m.RpId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RpId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CredentialIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SignCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BackupState {
+		i--
+		if m.BackupState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BackupEligible {
+		i--
+		if m.BackupEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UserVerified {
+		i--
+		if m.UserVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UserPresent {
+		i--
+		if m.UserPresent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeBinding != nil {
+		{
+			size, err := m.ChallengeBinding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UserVerificationRequired {
+		i--
+		if m.UserVerificationRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Origins[iNdEx])
+			copy(dAtA[i:], m.Origins[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Origins[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RpId) > 0 {
+		i -= len(m.RpId)
+		copy(dAtA[i:], m.RpId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RpId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credential != nil {
+		{
+			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNCredentialsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNCredentialsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNCredentialsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignDocHash) > 0 {
+		i -= len(m.SignDocHash)
+		copy(dAtA[i:], m.SignDocHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignDocHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWebAuthNChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWebAuthNChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWebAuthNChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountMigrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountMigrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountMigrationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Migration != nil {
+		{
+			size, err := m.Migration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWebAuthNVerifyRegisterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RpId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Origins) > 0 {
+		for _, s := range m.Origins {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AttestationPolicy != nil {
+		l = m.AttestationPolicy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChallengeBinding != nil {
+		l = m.ChallengeBinding.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNVerifyRegisterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RpId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Origins) > 0 {
+		for _, s := range m.Origins {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.UserVerificationRequired {
+		n += 2
+	}
+	if len(m.Credentials) > 0 {
+		for _, b := range m.Credentials {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ChallengeBinding != nil {
+		l = m.ChallengeBinding.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UserPresent {
+		n += 2
+	}
+	if m.UserVerified {
+		n += 2
+	}
+	if m.BackupEligible {
+		n += 2
+	}
+	if m.BackupState {
+		n += 2
+	}
+	if m.SignCount != 0 {
+		n += 1 + sovQuery(uint64(m.SignCount))
+	}
+	if m.CredentialIndex != 0 {
+		n += 1 + sovQuery(uint64(m.CredentialIndex))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.Data)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	l = len(m.RpId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Origins) > 0 {
+		for _, s := range m.Origins {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.UserVerificationRequired {
+		n += 2
+	}
+	if m.ChallengeBinding != nil {
+		l = m.ChallengeBinding.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credential != nil {
+		l = m.Credential.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNCredentialsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNCredentialsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAttestationPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SignDocHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWebAuthNChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryAccountMigrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAccountMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Migration != nil {
+		l = m.Migration.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttestationPolicy == nil {
+				m.AttestationPolicy = &AttestationPolicy{}
+			}
+			if err := m.AttestationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeBinding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChallengeBinding == nil {
+				m.ChallengeBinding = &WebAuthnChallengeBinding{}
+			}
+			if err := m.ChallengeBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
+			if m.Credential == nil {
+				m.Credential = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
+			if m.Credential == nil {
+				m.Credential = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVerificationRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserVerificationRequired = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = append(m.Credentials, make([]byte, postIndex-iNdEx))
+			copy(m.Credentials[len(m.Credentials)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeBinding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChallengeBinding == nil {
+				m.ChallengeBinding = &WebAuthnChallengeBinding{}
+			}
+			if err := m.ChallengeBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNVerifyAuthenticateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialId = append(m.CredentialId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialId == nil {
+				m.CredentialId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPresent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserPresent = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserVerified = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackupEligible = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackupState = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignCount", wireType)
+			}
+			m.SignCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialIndex", wireType)
+			}
+			m.CredentialIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CredentialIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNVerifyAuthenticateStoredRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateStoredRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateStoredRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVerificationRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserVerificationRequired = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeBinding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChallengeBinding == nil {
+				m.ChallengeBinding = &WebAuthnChallengeBinding{}
+			}
+			if err := m.ChallengeBinding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNCredentialRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialId = append(m.CredentialId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialId == nil {
+				m.CredentialId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryWebAuthNVerifyRegisterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Credential)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryWebAuthNVerifyAuthenticateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Challenge)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Rp)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Credential)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWebAuthNCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credential == nil {
+				m.Credential = &WebAuthnCredential{}
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryWebAuthNVerifyAuthenticateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWebAuthNCredentialsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -684,10 +4052,10 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -724,9 +4092,9 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -736,29 +4104,83 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNCredentialsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNCredentialsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -768,29 +4190,31 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rp = string(dAtA[iNdEx:postIndex])
+			m.Credentials = append(m.Credentials, &WebAuthnCredential{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -800,26 +4224,78 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -841,7 +4317,7 @@ func (m *QueryWebAuthNVerifyRegisterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -864,17 +4340,17 @@ func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyRegisterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -884,24 +4360,26 @@ func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
-			if m.Credential == nil {
-				m.Credential = []byte{}
+			if m.Policy == nil {
+				m.Policy = &AttestationPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -925,7 +4403,7 @@ func (m *QueryWebAuthNVerifyRegisterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWebAuthNChallengeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -948,10 +4426,10 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWebAuthNChallengeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWebAuthNChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -988,9 +4466,9 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignDocHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1000,27 +4478,79 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = string(dAtA[iNdEx:postIndex])
+			m.SignDocHash = append(m.SignDocHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignDocHash == nil {
+				m.SignDocHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWebAuthNChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWebAuthNChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWebAuthNChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1048,13 +4578,13 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rp = string(dAtA[iNdEx:postIndex])
+			m.Challenge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1064,31 +4594,29 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credential = append(m.Credential[:0], dAtA[iNdEx:postIndex]...)
-			if m.Credential == nil {
-				m.Credential = []byte{}
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
 			}
-			var byteLen int
+			m.AccountNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1098,26 +4626,80 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.AccountNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountMigrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountMigrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountMigrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1139,7 +4721,7 @@ func (m *QueryWebAuthNVerifyAuthenticateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWebAuthNVerifyAuthenticateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountMigrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1162,12 +4744,67 @@ func (m *QueryWebAuthNVerifyAuthenticateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountMigrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWebAuthNVerifyAuthenticateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Migration == nil {
+				m.Migration = &AccountMigration{}
+			}
+			if err := m.Migration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+const androidOriginPrefix = "android:apk-key-hash:"
+
+// RelyingParty is the WebAuthn relying party a ceremony is verified for,
+// identified by its RP ID and the origins allowed to act on its behalf
+type RelyingParty struct {
+	ID      string
+	Origins []string
+}
+
+// NewRelyingPartyFromURL returns the relying party of a single origin, using
+// the origin's host as the RP ID
+func NewRelyingPartyFromURL(rp *url.URL) *RelyingParty {
+	return &RelyingParty{
+		ID:      rp.Host,
+		Origins: []string{rp.String()},
+	}
+}
+
+// NewRelyingParty builds the relying party of a request. Without an explicit
+// RP ID or origins, the single rp origin is used as before. Otherwise every
+// web origin, including rp, must be the RP ID or one of its subdomains.
+func NewRelyingParty(rp, rpID string, origins []string) (*RelyingParty, error) {
+	if rpID == "" && len(origins) == 0 {
+		rpURL, err := url.Parse(rp)
+		if err != nil {
+			return nil, err
+		}
+		return NewRelyingPartyFromURL(rpURL), nil
+	}
+
+	if rpID == "" {
+		return nil, fmt.Errorf("rp id is required when origins are set")
+	}
+	if strings.Contains(rpID, "/") || strings.Contains(rpID, ":") {
+		return nil, fmt.Errorf("rp id %s must be a domain", rpID)
+	}
+
+	relyingParty := &RelyingParty{ID: strings.ToLower(rpID)}
+	if rp != "" {
+		origins = append([]string{rp}, origins...)
+	}
+
+	seen := make(map[string]bool)
+	for _, origin := range origins {
+		fqOrigin, err := relyingParty.validateOrigin(origin)
+		if err != nil {
+			return nil, err
+		}
+		if seen[fqOrigin] {
+			continue
+		}
+		seen[fqOrigin] = true
+		relyingParty.Origins = append(relyingParty.Origins, fqOrigin)
+	}
+
+	if len(relyingParty.Origins) == 0 {
+		return nil, fmt.Errorf("at least one origin is required")
+	}
+
+	return relyingParty, nil
+}
+
+// validateOrigin returns the fully qualified origin if it may act for the
+// relying party. Android app origins are not domain bound.
+func (rp RelyingParty) validateOrigin(origin string) (string, error) {
+	if strings.HasPrefix(origin, androidOriginPrefix) {
+		if len(origin) == len(androidOriginPrefix) {
+			return "", fmt.Errorf("empty android apk key hash")
+		}
+		return origin, nil
+	}
+
+	fqOrigin, err := protocol.FullyQualifiedOrigin(origin)
+	if err != nil {
+		return "", fmt.Errorf("invalid origin %s: %w", origin, err)
+	}
+
+	originURL, err := url.Parse(fqOrigin)
+	if err != nil {
+		return "", err
+	}
+
+	host := strings.ToLower(originURL.Hostname())
+	if host != rp.ID && !strings.HasSuffix(host, "."+rp.ID) {
+		return "", fmt.Errorf("origin %s is not within rp id %s", origin, rp.ID)
+	}
+
+	return strings.ToLower(fqOrigin), nil
+}
+
+// Config returns the webauthn configuration of the relying party
+func (rp RelyingParty) Config() *webauthn.Config {
+	return &webauthn.Config{
+		RPID:                   rp.ID,
+		RPDisplayName:          rp.ID,
+		RPOrigins:              rp.Origins,
+		AttestationPreference:  "",
+		AuthenticatorSelection: protocol.AuthenticatorSelection{},
+	}
+}
+
+// verifyTopOrigin checks the top level origin reported for cross-origin
+// ceremonies, such as those run in an iframe, against the allowed origins
+func (rp RelyingParty) verifyTopOrigin(clientDataJSON []byte) error {
+	var clientData struct {
+		CrossOrigin bool   `json:"crossOrigin"`
+		TopOrigin   string `json:"topOrigin"`
+	}
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return protocol.ErrParsingData.WithDetails("Error unmarshalling client data json")
+	}
+
+	if clientData.TopOrigin == "" {
+		return nil
+	}
+
+	fqOrigin, err := protocol.FullyQualifiedOrigin(clientData.TopOrigin)
+	if err != nil {
+		return protocol.ErrParsingData.WithDetails("Error decoding clientData topOrigin as URL")
+	}
+
+	for _, origin := range rp.Origins {
+		if strings.EqualFold(fqOrigin, origin) {
+			return nil
+		}
+	}
+
+	return protocol.ErrVerification.
+		WithDetails("Error validating top origin").
+		WithInfo(fmt.Sprintf("Expected Values: %s, Received: %s", rp.Origins, fqOrigin))
+}
@@ -0,0 +1,136 @@
+package types_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dvsekhvalnov/jose2go/base64url"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stretchr/testify/require"
+
+	"github.com/burnt-labs/xion/x/xion/types"
+)
+
+const testRPID = "xion-dapp-example-git-feat-faceid-burntfinance.vercel.app"
+
+func TestNewRelyingParty(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rp      string
+		rpID    string
+		origins []string
+		expErr  bool
+		expRP   *types.RelyingParty
+	}{
+		{
+			name:  "legacy single origin",
+			rp:    "https://xion.burnt.com",
+			expRP: &types.RelyingParty{ID: "xion.burnt.com", Origins: []string{"https://xion.burnt.com"}},
+		},
+		{
+			name:    "subdomains and android origins",
+			rp:      "https://burnt.com",
+			rpID:    "burnt.com",
+			origins: []string{"https://staging.burnt.com/login", "https://burnt.com", "android:apk-key-hash:abc"},
+			expRP:   &types.RelyingParty{ID: "burnt.com", Origins: []string{"https://burnt.com", "https://staging.burnt.com", "android:apk-key-hash:abc"}},
+		},
+		{
+			name:    "origins without rp id",
+			origins: []string{"https://burnt.com"},
+			expErr:  true,
+		},
+		{
+			name:    "origin outside rp id",
+			rpID:    "burnt.com",
+			origins: []string{"https://notburnt.com"},
+			expErr:  true,
+		},
+		{
+			name:   "rp id without origins",
+			rpID:   "burnt.com",
+			expErr: true,
+		},
+		{
+			name:    "rp id with scheme",
+			rpID:    "https://burnt.com",
+			origins: []string{"https://burnt.com"},
+			expErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rp, err := types.NewRelyingParty(tc.rp, tc.rpID, tc.origins)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expRP, rp)
+		})
+	}
+}
+
+func TestRelyingPartyOrigins(t *testing.T) {
+	bec32Addr := "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq"
+	challenge := base64url.Encode([]byte(bec32Addr))
+
+	rp, err := types.NewRelyingParty("", testRPID, []string{"android:apk-key-hash:abc", "https://" + testRPID})
+	require.NoError(t, err)
+
+	data, err := protocol.ParseCredentialCreationResponseBody(strings.NewReader(testRegisterResponse))
+	require.NoError(t, err)
+	cred, err := types.VerifyRegistration(rp, bec32Addr, challenge, data)
+	require.NoError(t, err)
+
+	authData, err := protocol.ParseCredentialRequestResponseBody(strings.NewReader(testAssertionResponse))
+	require.NoError(t, err)
+	verified, err := types.VerifyAuthentication(rp, bec32Addr, "MfaOZjuIdKFbXkLKWbPghSL8w41RsK2Issp4i0TwzvU=", cred, authData)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	// the origin of the ceremony must be one of the allowed origins
+	rp, err = types.NewRelyingParty("", testRPID, []string{"https://staging." + testRPID})
+	require.NoError(t, err)
+	_, err = types.VerifyRegistration(rp, bec32Addr, challenge, data)
+	require.Error(t, err)
+}
+
+func TestRelyingPartyTopOrigin(t *testing.T) {
+	bec32Addr := "xion1ncx0a0jnsyay7udd03ah2gf64772g02qswj52996dy80qfvgnmzq6eplqq"
+	challenge := base64url.Encode([]byte(bec32Addr))
+
+	// the client data of a none attestation is not signed, so it can be
+	// rewritten to simulate a ceremony run in a cross-origin iframe
+	withTopOrigin := func(topOrigin string) *protocol.ParsedCredentialCreationData {
+		var response map[string]any
+		require.NoError(t, json.Unmarshal([]byte(testRegisterResponse), &response))
+		attestation := response["response"].(map[string]any)
+
+		var clientDataJSON protocol.URLEncodedBase64
+		require.NoError(t, clientDataJSON.UnmarshalJSON([]byte(`"`+attestation["clientDataJSON"].(string)+`"`)))
+		var clientData map[string]any
+		require.NoError(t, json.Unmarshal(clientDataJSON, &clientData))
+		clientData["crossOrigin"] = true
+		clientData["topOrigin"] = topOrigin
+		clientDataBz, err := json.Marshal(clientData)
+		require.NoError(t, err)
+		attestation["clientDataJSON"] = base64url.Encode(clientDataBz)
+
+		bz, err := json.Marshal(response)
+		require.NoError(t, err)
+		data, err := protocol.ParseCredentialCreationResponseBody(strings.NewReader(string(bz)))
+		require.NoError(t, err)
+		return data
+	}
+
+	rp, err := types.NewRelyingParty("", testRPID, []string{"https://" + testRPID, "https://embed." + testRPID})
+	require.NoError(t, err)
+
+	_, err = types.VerifyRegistration(rp, bec32Addr, challenge, withTopOrigin("https://embed."+testRPID))
+	require.NoError(t, err)
+
+	_, err = types.VerifyRegistration(rp, bec32Addr, challenge, withTopOrigin("https://evil.com"))
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_CosmWasm_wasmd_x_wasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -292,534 +293,3516 @@ func (m *MsgSetPlatformPercentageResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetPlatformPercentageResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSend)(nil), "xion.v1.MsgSend")
-	proto.RegisterType((*MsgSendResponse)(nil), "xion.v1.MsgSendResponse")
-	proto.RegisterType((*MsgMultiSend)(nil), "xion.v1.MsgMultiSend")
-	proto.RegisterType((*MsgMultiSendResponse)(nil), "xion.v1.MsgMultiSendResponse")
-	proto.RegisterType((*MsgSetPlatformPercentage)(nil), "xion.v1.MsgSetPlatformPercentage")
-	proto.RegisterType((*MsgSetPlatformPercentageResponse)(nil), "xion.v1.MsgSetPlatformPercentageResponse")
+// MsgRegisterWebAuthNCredential registers a WebAuthn credential for an
+// account. The registration response must be bound to the account address.
+type MsgRegisterWebAuthNCredential struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Rp        string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	// data is the JSON encoded credential creation response
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// rp_id is the relying party id the credential is scoped to, defaults to
+	// the host of rp
+	RpId string `protobuf:"bytes,5,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// origins are the additional origins allowed to act for rp_id
+	Origins []string `protobuf:"bytes,6,rep,name=origins,proto3" json:"origins,omitempty"`
+	// attestation_policy is an optional policy applied in addition to the
+	// module attestation policy
+	AttestationPolicy *AttestationPolicy `protobuf:"bytes,7,opt,name=attestation_policy,json=attestationPolicy,proto3" json:"attestation_policy,omitempty"`
 }
 
-func init() { proto.RegisterFile("xion/v1/tx.proto", fileDescriptor_5076275aa290c9b8) }
-
-var fileDescriptor_5076275aa290c9b8 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x8f, 0xd2, 0x40,
-	0x14, 0x6e, 0x41, 0x21, 0xcc, 0xae, 0x71, 0xa9, 0xac, 0xb2, 0x35, 0x5b, 0xb0, 0x89, 0x11, 0x49,
-	0x68, 0x05, 0xa3, 0x26, 0x18, 0x13, 0xc1, 0x93, 0x07, 0xe2, 0x86, 0xbd, 0x79, 0x21, 0x2d, 0xcc,
-	0x96, 0x06, 0x3a, 0xd3, 0x74, 0xa6, 0x84, 0xbd, 0x19, 0x4f, 0xc6, 0x93, 0x17, 0xef, 0x7b, 0x34,
-	0x9e, 0xf6, 0xa0, 0xfe, 0x86, 0x3d, 0x6e, 0x3c, 0x79, 0x52, 0x03, 0x87, 0x35, 0xfe, 0x0a, 0x33,
-	0xd3, 0x69, 0x61, 0x5d, 0x36, 0x5c, 0xe8, 0xcc, 0x7b, 0xef, 0xfb, 0xe6, 0xfb, 0xde, 0x9b, 0x01,
-	0x6c, 0x4d, 0x5d, 0x8c, 0xcc, 0x49, 0xdd, 0xa4, 0x53, 0xc3, 0x0f, 0x30, 0xc5, 0x4a, 0x96, 0x45,
-	0x8c, 0x49, 0x5d, 0x2d, 0x38, 0xd8, 0xc1, 0x3c, 0x66, 0xb2, 0x55, 0x94, 0x56, 0x6f, 0xf5, 0x31,
-	0xf1, 0x30, 0x31, 0x3d, 0xe2, 0x30, 0x98, 0x47, 0x1c, 0x91, 0xc8, 0x5b, 0x9e, 0x8b, 0xb0, 0xc9,
-	0x7f, 0x45, 0x68, 0x27, 0xaa, 0xed, 0x45, 0x24, 0xd1, 0x46, 0xa4, 0x34, 0x41, 0x63, 0x5b, 0x04,
-	0x9a, 0x93, 0xba, 0x0d, 0xa9, 0x55, 0x37, 0xfb, 0xd8, 0x45, 0x17, 0xf2, 0x68, 0x94, 0xe4, 0xd9,
-	0x26, 0xca, 0xeb, 0x1f, 0x53, 0x20, 0xdb, 0x21, 0xce, 0x3e, 0x44, 0x03, 0xe5, 0x29, 0xd8, 0x3c,
-	0x08, 0xb0, 0xd7, 0xb3, 0x06, 0x83, 0x00, 0x12, 0x52, 0x94, 0xcb, 0x72, 0x25, 0xd7, 0x2e, 0x7e,
-	0xff, 0x52, 0x2b, 0x88, 0x33, 0x5b, 0x51, 0x66, 0x9f, 0x06, 0x2e, 0x72, 0xba, 0x1b, 0xac, 0x5a,
-	0x84, 0x94, 0x27, 0x00, 0x50, 0x9c, 0x40, 0x53, 0x6b, 0xa0, 0x39, 0x8a, 0x63, 0xe0, 0x10, 0x64,
-	0x2c, 0x0f, 0x87, 0x88, 0x16, 0xd3, 0xe5, 0x74, 0x65, 0xa3, 0xb1, 0x63, 0x08, 0x04, 0xb3, 0x64,
-	0x08, 0xc9, 0xc6, 0x0b, 0xec, 0xa2, 0xf6, 0xa3, 0x93, 0x9f, 0x25, 0xe9, 0xf3, 0xaf, 0x52, 0xc5,
-	0x71, 0xe9, 0x30, 0xb4, 0x8d, 0x3e, 0xf6, 0x44, 0x37, 0xc4, 0xa7, 0x46, 0x06, 0x23, 0x93, 0x1e,
-	0xfa, 0x90, 0x70, 0x00, 0xf9, 0x74, 0x76, 0x5c, 0x95, 0xbb, 0x82, 0xbf, 0x59, 0x7d, 0x77, 0x54,
-	0x92, 0xfe, 0x1c, 0x95, 0xa4, 0xb7, 0x67, 0xc7, 0xd5, 0x73, 0x56, 0xdf, 0xb3, 0x00, 0x9f, 0xa0,
-	0xe8, 0x85, 0x9e, 0x07, 0xd7, 0xc5, 0xb2, 0x0b, 0x89, 0x8f, 0x11, 0x81, 0xfa, 0x37, 0x19, 0x6c,
-	0x76, 0x88, 0xd3, 0x09, 0xc7, 0xd4, 0xe5, 0xfd, 0x7a, 0x06, 0x32, 0x2e, 0xf2, 0x43, 0xca, 0x3a,
-	0xc5, 0x94, 0xab, 0x0b, 0xe5, 0x68, 0x94, 0x28, 0x7f, 0xc9, 0x4a, 0xda, 0x39, 0x26, 0x5d, 0xc8,
-	0x89, 0x40, 0xca, 0x73, 0x90, 0xc5, 0x21, 0xe5, 0xf8, 0x14, 0xc7, 0xdf, 0x5e, 0x89, 0x7f, 0xc5,
-	0x6b, 0x96, 0x09, 0x62, 0x58, 0xf3, 0x6e, 0x6c, 0x46, 0x50, 0x32, 0x1b, 0xf9, 0xd8, 0x46, 0xa2,
-	0x53, 0xbf, 0x09, 0x0a, 0xcb, 0xfb, 0xc4, 0xd0, 0x57, 0x19, 0x14, 0xb9, 0x49, 0xba, 0x37, 0xb6,
-	0xe8, 0x01, 0x0e, 0xbc, 0x3d, 0x18, 0xf4, 0x21, 0xa2, 0x96, 0x03, 0x95, 0xc7, 0x20, 0x67, 0x85,
-	0x74, 0x88, 0x03, 0x97, 0x1e, 0xae, 0xbd, 0x09, 0x8b, 0x52, 0xc5, 0x04, 0x37, 0x7c, 0xc1, 0xd6,
-	0xf3, 0x13, 0x3a, 0x7e, 0x21, 0xae, 0x75, 0x15, 0xff, 0xc2, 0x41, 0xcd, 0x07, 0xcc, 0xc0, 0x82,
-	0x80, 0x79, 0xd8, 0x5d, 0x8c, 0x62, 0x85, 0x34, 0x5d, 0x07, 0xe5, 0xcb, 0x72, 0xb1, 0xb7, 0xc6,
-	0x5f, 0x19, 0xa4, 0x3b, 0xc4, 0x51, 0x1a, 0xe0, 0x0a, 0x9f, 0xd5, 0x96, 0x21, 0x9e, 0xa3, 0x21,
-	0xc6, 0xaa, 0x16, 0xff, 0x8f, 0xc4, 0x58, 0xa5, 0x05, 0x72, 0x8b, 0x21, 0x6f, 0x2f, 0x97, 0x25,
-	0x61, 0x75, 0x77, 0x65, 0x38, 0xa1, 0x80, 0x60, 0x7b, 0x75, 0x5b, 0xef, 0x9c, 0x3f, 0x75, 0x45,
-	0x89, 0x7a, 0x7f, 0x6d, 0x49, 0x7c, 0x8c, 0x7a, 0xf5, 0x0d, 0xbb, 0x10, 0xed, 0xd6, 0xc9, 0x4c,
-	0x93, 0x4f, 0x67, 0x9a, 0xfc, 0x7b, 0xa6, 0xc9, 0x1f, 0xe6, 0x9a, 0x74, 0x3a, 0xd7, 0xa4, 0x1f,
-	0x73, 0x4d, 0x7a, 0x7d, 0x6f, 0xe9, 0xa5, 0xd8, 0x61, 0x80, 0x68, 0x6d, 0x6c, 0xd9, 0xc4, 0xe4,
-	0xfd, 0x9d, 0x46, 0x1f, 0xfe, 0x5c, 0xec, 0x0c, 0xff, 0x3b, 0x78, 0xf8, 0x2f, 0x00, 0x00, 0xff,
-	0xff, 0x33, 0x6e, 0xe5, 0xa1, 0xc8, 0x04, 0x00, 0x00,
+func (m *MsgRegisterWebAuthNCredential) Reset()         { *m = MsgRegisterWebAuthNCredential{} }
+func (m *MsgRegisterWebAuthNCredential) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterWebAuthNCredential) ProtoMessage()    {}
+func (*MsgRegisterWebAuthNCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{6}
+}
+func (m *MsgRegisterWebAuthNCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterWebAuthNCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterWebAuthNCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterWebAuthNCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterWebAuthNCredential.Merge(m, src)
+}
+func (m *MsgRegisterWebAuthNCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterWebAuthNCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterWebAuthNCredential.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterWebAuthNCredential proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Send defines a method for sending coins from one account to another
-	// account.
-	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
-	// MultiSend defines a method for sending coins from some accounts to other
-	// accounts.
-	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
-	// SetPlatformPercentage defines the method for updating the platform
-	// percentage fee
-	SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error)
+func (m *MsgRegisterWebAuthNCredential) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterWebAuthNCredential) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterWebAuthNCredential) GetRp() string {
+	if m != nil {
+		return m.Rp
+	}
+	return ""
 }
 
-func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
-	out := new(MsgSendResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Msg/Send", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterWebAuthNCredential) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
-	out := new(MsgMultiSendResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Msg/MultiSend", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterWebAuthNCredential) GetRpId() string {
+	if m != nil {
+		return m.RpId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error) {
-	out := new(MsgSetPlatformPercentageResponse)
-	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformPercentage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterWebAuthNCredential) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Send defines a method for sending coins from one account to another
-	// account.
-	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
-	// MultiSend defines a method for sending coins from some accounts to other
-	// accounts.
-	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
-	// SetPlatformPercentage defines the method for updating the platform
-	// percentage fee
-	SetPlatformPercentage(context.Context, *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error)
+func (m *MsgRegisterWebAuthNCredential) GetAttestationPolicy() *AttestationPolicy {
+	if m != nil {
+		return m.AttestationPolicy
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRegisterWebAuthNCredentialResponse struct {
+	Credential *WebAuthnCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+func (m *MsgRegisterWebAuthNCredentialResponse) Reset()         { *m = MsgRegisterWebAuthNCredentialResponse{} }
+func (m *MsgRegisterWebAuthNCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterWebAuthNCredentialResponse) ProtoMessage()    {}
+func (*MsgRegisterWebAuthNCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{7}
 }
-func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+func (m *MsgRegisterWebAuthNCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetPlatformPercentage(ctx context.Context, req *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformPercentage not implemented")
+func (m *MsgRegisterWebAuthNCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterWebAuthNCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterWebAuthNCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterWebAuthNCredentialResponse.Merge(m, src)
+}
+func (m *MsgRegisterWebAuthNCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterWebAuthNCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterWebAuthNCredentialResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRegisterWebAuthNCredentialResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterWebAuthNCredentialResponse) GetCredential() *WebAuthnCredential {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
 }
 
-func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSend)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgRemoveWebAuthNCredential removes a stored WebAuthn credential
+type MsgRemoveWebAuthNCredential struct {
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CredentialId []byte `protobuf:"bytes,2,opt,name=credential_id,json=credentialId,proto3" json:"credential_id,omitempty"`
+}
+
+func (m *MsgRemoveWebAuthNCredential) Reset()         { *m = MsgRemoveWebAuthNCredential{} }
+func (m *MsgRemoveWebAuthNCredential) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWebAuthNCredential) ProtoMessage()    {}
+func (*MsgRemoveWebAuthNCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{8}
+}
+func (m *MsgRemoveWebAuthNCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWebAuthNCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWebAuthNCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Send(ctx, in)
+}
+func (m *MsgRemoveWebAuthNCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWebAuthNCredential.Merge(m, src)
+}
+func (m *MsgRemoveWebAuthNCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWebAuthNCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWebAuthNCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWebAuthNCredential proto.InternalMessageInfo
+
+func (m *MsgRemoveWebAuthNCredential) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Msg/Send",
+	return ""
+}
+
+func (m *MsgRemoveWebAuthNCredential) GetCredentialId() []byte {
+	if m != nil {
+		return m.CredentialId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+	return nil
+}
+
+type MsgRemoveWebAuthNCredentialResponse struct {
+}
+
+func (m *MsgRemoveWebAuthNCredentialResponse) Reset()         { *m = MsgRemoveWebAuthNCredentialResponse{} }
+func (m *MsgRemoveWebAuthNCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWebAuthNCredentialResponse) ProtoMessage()    {}
+func (*MsgRemoveWebAuthNCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{9}
+}
+func (m *MsgRemoveWebAuthNCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWebAuthNCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWebAuthNCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveWebAuthNCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWebAuthNCredentialResponse.Merge(m, src)
+}
+func (m *MsgRemoveWebAuthNCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWebAuthNCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWebAuthNCredentialResponse.DiscardUnknown(m)
 }
 
-func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiSend)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveWebAuthNCredentialResponse proto.InternalMessageInfo
+
+// MsgAuthenticateWebAuthNCredential verifies an assertion against a stored
+// credential. A sign count that does not increase flags the credential as
+// possibly cloned, after which it can no longer authenticate.
+type MsgAuthenticateWebAuthNCredential struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Challenge string `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Rp        string `protobuf:"bytes,3,opt,name=rp,proto3" json:"rp,omitempty"`
+	// data is the JSON encoded credential assertion response
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// rp_id is the relying party id the credential is scoped to, defaults to
+	// the host of rp
+	RpId string `protobuf:"bytes,5,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// origins are the additional origins allowed to act for rp_id
+	Origins []string `protobuf:"bytes,6,rep,name=origins,proto3" json:"origins,omitempty"`
+	// user_verification_required rejects assertions in which the
+	// authenticator did not verify the user
+	UserVerificationRequired bool `protobuf:"varint,7,opt,name=user_verification_required,json=userVerificationRequired,proto3" json:"user_verification_required,omitempty"`
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) Reset()         { *m = MsgAuthenticateWebAuthNCredential{} }
+func (m *MsgAuthenticateWebAuthNCredential) String() string { return proto.CompactTextString(m) }
+func (*MsgAuthenticateWebAuthNCredential) ProtoMessage()    {}
+func (*MsgAuthenticateWebAuthNCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{10}
+}
+func (m *MsgAuthenticateWebAuthNCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthenticateWebAuthNCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthenticateWebAuthNCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiSend(ctx, in)
+}
+func (m *MsgAuthenticateWebAuthNCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthenticateWebAuthNCredential.Merge(m, src)
+}
+func (m *MsgAuthenticateWebAuthNCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthenticateWebAuthNCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthenticateWebAuthNCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAuthenticateWebAuthNCredential proto.InternalMessageInfo
+
+func (m *MsgAuthenticateWebAuthNCredential) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Msg/MultiSend",
+	return ""
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
+	return ""
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) GetRp() string {
+	if m != nil {
+		return m.Rp
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetPlatformPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetPlatformPercentage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgAuthenticateWebAuthNCredential) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetPlatformPercentage(ctx, in)
+	return nil
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) GetRpId() string {
+	if m != nil {
+		return m.RpId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/xion.v1.Msg/SetPlatformPercentage",
+	return ""
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) GetOrigins() []string {
+	if m != nil {
+		return m.Origins
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetPlatformPercentage(ctx, req.(*MsgSetPlatformPercentage))
+	return nil
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) GetUserVerificationRequired() bool {
+	if m != nil {
+		return m.UserVerificationRequired
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "xion.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Send",
-			Handler:    _Msg_Send_Handler,
-		},
-		{
-			MethodName: "MultiSend",
-			Handler:    _Msg_MultiSend_Handler,
-		},
-		{
-			MethodName: "SetPlatformPercentage",
-			Handler:    _Msg_SetPlatformPercentage_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "xion/v1/tx.proto",
+type MsgAuthenticateWebAuthNCredentialResponse struct {
+	// credential is the updated credential. Its clone_warning is set when the
+	// sign count of the assertion did not increase, callers must then treat the
+	// assertion as failed. The msg still succeeds so the flag is persisted, and
+	// the credential fails to authenticate from then on.
+	Credential *WebAuthnCredential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
 }
 
-func (m *MsgSend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAuthenticateWebAuthNCredentialResponse) Reset() {
+	*m = MsgAuthenticateWebAuthNCredentialResponse{}
+}
+func (m *MsgAuthenticateWebAuthNCredentialResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAuthenticateWebAuthNCredentialResponse) ProtoMessage() {}
+func (*MsgAuthenticateWebAuthNCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{11}
+}
+func (m *MsgAuthenticateWebAuthNCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAuthenticateWebAuthNCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAuthenticateWebAuthNCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAuthenticateWebAuthNCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAuthenticateWebAuthNCredentialResponse.Merge(m, src)
+}
+func (m *MsgAuthenticateWebAuthNCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAuthenticateWebAuthNCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAuthenticateWebAuthNCredentialResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAuthenticateWebAuthNCredentialResponse proto.InternalMessageInfo
+
+func (m *MsgAuthenticateWebAuthNCredentialResponse) GetCredential() *WebAuthnCredential {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
 }
 
-func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+type MsgSetAttestationPolicy struct {
+	Authority string            `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Policy    AttestationPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetAttestationPolicy) Reset()         { *m = MsgSetAttestationPolicy{} }
+func (m *MsgSetAttestationPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttestationPolicy) ProtoMessage()    {}
+func (*MsgSetAttestationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{12}
+}
+func (m *MsgSetAttestationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttestationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttestationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetAttestationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttestationPolicy.Merge(m, src)
+}
+func (m *MsgSetAttestationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttestationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttestationPolicy.DiscardUnknown(m)
 }
 
-func (m *MsgSendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAttestationPolicy proto.InternalMessageInfo
+
+func (m *MsgSetAttestationPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAttestationPolicy) GetPolicy() AttestationPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return AttestationPolicy{}
 }
 
-func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetAttestationPolicyResponse struct {
 }
 
-func (m *MsgMultiSend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAttestationPolicyResponse) Reset()         { *m = MsgSetAttestationPolicyResponse{} }
+func (m *MsgSetAttestationPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttestationPolicyResponse) ProtoMessage()    {}
+func (*MsgSetAttestationPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{13}
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttestationPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttestationPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttestationPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttestationPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAttestationPolicyResponse proto.InternalMessageInfo
+
+// MsgMigrateAccounts pins new_code_id, makes it the abstract account code in
+// place of old_code_ids and schedules the migration of the existing accounts
+type MsgMigrateAccounts struct {
+	Authority  string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	OldCodeIds []uint64 `protobuf:"varint,2,rep,packed,name=old_code_ids,json=oldCodeIds,proto3" json:"old_code_ids,omitempty"`
+	NewCodeId  uint64   `protobuf:"varint,3,opt,name=new_code_id,json=newCodeId,proto3" json:"new_code_id,omitempty"`
+	// migrate_msg is the JSON message passed to each account's migrate entry
+	// point
+	MigrateMsg github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,4,opt,name=migrate_msg,json=migrateMsg,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"migrate_msg,omitempty"`
+	// batch_size is the maximum number of accounts migrated per block, zero
+	// uses the default
+	BatchSize uint64 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 }
 
-func (m *MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgMigrateAccounts) Reset()         { *m = MsgMigrateAccounts{} }
+func (m *MsgMigrateAccounts) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccounts) ProtoMessage()    {}
+func (*MsgMigrateAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{14}
+}
+func (m *MsgMigrateAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgMultiSendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgMigrateAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccounts.Merge(m, src)
 }
-
-func (m *MsgMultiSendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateAccounts) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgMigrateAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccounts.DiscardUnknown(m)
 }
 
-func (m *MsgSetPlatformPercentage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgMigrateAccounts proto.InternalMessageInfo
+
+func (m *MsgMigrateAccounts) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetPlatformPercentage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMigrateAccounts) GetOldCodeIds() []uint64 {
+	if m != nil {
+		return m.OldCodeIds
+	}
+	return nil
 }
 
-func (m *MsgSetPlatformPercentage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PlatformPercentage != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PlatformPercentage))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgMigrateAccounts) GetNewCodeId() uint64 {
+	if m != nil {
+		return m.NewCodeId
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgMigrateAccounts) GetMigrateMsg() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.MigrateMsg
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgSetPlatformPercentageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMigrateAccounts) GetBatchSize() uint64 {
+	if m != nil {
+		return m.BatchSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetPlatformPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgMigrateAccountsResponse struct {
 }
 
-func (m *MsgSetPlatformPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgMigrateAccountsResponse) Reset()         { *m = MsgMigrateAccountsResponse{} }
+func (m *MsgMigrateAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateAccountsResponse) ProtoMessage()    {}
+func (*MsgMigrateAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{15}
+}
+func (m *MsgMigrateAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateAccountsResponse.Merge(m, src)
+}
+func (m *MsgMigrateAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateAccountsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgMigrateAccountsResponse proto.InternalMessageInfo
+
+// MsgCancelAccountMigration stops the abstract account migration in progress.
+// Contracts already migrated stay on the new code and the others on their
+// old code, which stays pinned.
+type MsgCancelAccountMigration struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgCancelAccountMigration) Reset()         { *m = MsgCancelAccountMigration{} }
+func (m *MsgCancelAccountMigration) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAccountMigration) ProtoMessage()    {}
+func (*MsgCancelAccountMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{16}
+}
+func (m *MsgCancelAccountMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAccountMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAccountMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelAccountMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAccountMigration.Merge(m, src)
+}
+func (m *MsgCancelAccountMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAccountMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAccountMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAccountMigration proto.InternalMessageInfo
+
+func (m *MsgCancelAccountMigration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgCancelAccountMigrationResponse struct {
+}
+
+func (m *MsgCancelAccountMigrationResponse) Reset()         { *m = MsgCancelAccountMigrationResponse{} }
+func (m *MsgCancelAccountMigrationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAccountMigrationResponse) ProtoMessage()    {}
+func (*MsgCancelAccountMigrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5076275aa290c9b8, []int{17}
+}
+func (m *MsgCancelAccountMigrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelAccountMigrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelAccountMigrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCancelAccountMigrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAccountMigrationResponse.Merge(m, src)
+}
+func (m *MsgCancelAccountMigrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelAccountMigrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAccountMigrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelAccountMigrationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSend)(nil), "xion.v1.MsgSend")
+	proto.RegisterType((*MsgSendResponse)(nil), "xion.v1.MsgSendResponse")
+	proto.RegisterType((*MsgMultiSend)(nil), "xion.v1.MsgMultiSend")
+	proto.RegisterType((*MsgMultiSendResponse)(nil), "xion.v1.MsgMultiSendResponse")
+	proto.RegisterType((*MsgSetPlatformPercentage)(nil), "xion.v1.MsgSetPlatformPercentage")
+	proto.RegisterType((*MsgSetPlatformPercentageResponse)(nil), "xion.v1.MsgSetPlatformPercentageResponse")
+	proto.RegisterType((*MsgRegisterWebAuthNCredential)(nil), "xion.v1.MsgRegisterWebAuthNCredential")
+	proto.RegisterType((*MsgRegisterWebAuthNCredentialResponse)(nil), "xion.v1.MsgRegisterWebAuthNCredentialResponse")
+	proto.RegisterType((*MsgRemoveWebAuthNCredential)(nil), "xion.v1.MsgRemoveWebAuthNCredential")
+	proto.RegisterType((*MsgRemoveWebAuthNCredentialResponse)(nil), "xion.v1.MsgRemoveWebAuthNCredentialResponse")
+	proto.RegisterType((*MsgAuthenticateWebAuthNCredential)(nil), "xion.v1.MsgAuthenticateWebAuthNCredential")
+	proto.RegisterType((*MsgAuthenticateWebAuthNCredentialResponse)(nil), "xion.v1.MsgAuthenticateWebAuthNCredentialResponse")
+	proto.RegisterType((*MsgSetAttestationPolicy)(nil), "xion.v1.MsgSetAttestationPolicy")
+	proto.RegisterType((*MsgSetAttestationPolicyResponse)(nil), "xion.v1.MsgSetAttestationPolicyResponse")
+	proto.RegisterType((*MsgMigrateAccounts)(nil), "xion.v1.MsgMigrateAccounts")
+	proto.RegisterType((*MsgMigrateAccountsResponse)(nil), "xion.v1.MsgMigrateAccountsResponse")
+	proto.RegisterType((*MsgCancelAccountMigration)(nil), "xion.v1.MsgCancelAccountMigration")
+	proto.RegisterType((*MsgCancelAccountMigrationResponse)(nil), "xion.v1.MsgCancelAccountMigrationResponse")
+}
+
+func init() { proto.RegisterFile("xion/v1/tx.proto", fileDescriptor_5076275aa290c9b8) }
+
+var fileDescriptor_5076275aa290c9b8 = []byte{
+	// 1240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xbd, 0x6f, 0x1c, 0x45,
+	0x14, 0xf7, 0x9e, 0x2f, 0x3e, 0xee, 0xd9, 0x21, 0xf1, 0xc6, 0x49, 0x36, 0x9b, 0xf8, 0x7c, 0x5e,
+	0x93, 0xe0, 0x58, 0xe4, 0x16, 0x3b, 0x82, 0x88, 0x0b, 0x91, 0xb0, 0x5d, 0xb9, 0x38, 0x88, 0xd6,
+	0x12, 0x91, 0x28, 0x38, 0xcd, 0xed, 0x4e, 0xf6, 0x46, 0xb9, 0x9d, 0x39, 0x76, 0xe6, 0x6c, 0x27,
+	0x55, 0x44, 0x81, 0x10, 0x15, 0x0d, 0x7d, 0x4a, 0xa0, 0x4a, 0x01, 0x48, 0x54, 0x08, 0x89, 0x22,
+	0x65, 0x44, 0x45, 0x15, 0x50, 0x52, 0x84, 0xbf, 0x80, 0x82, 0x0a, 0xcd, 0xec, 0xec, 0xde, 0xb7,
+	0xcf, 0xb2, 0xd2, 0xd0, 0xdc, 0xee, 0xbe, 0xf7, 0x7b, 0x5f, 0xbf, 0x7d, 0xf3, 0xde, 0x1e, 0x9c,
+	0x3e, 0x20, 0x8c, 0xba, 0x7b, 0xeb, 0xae, 0x38, 0xa8, 0xb4, 0x63, 0x26, 0x98, 0x59, 0x90, 0x92,
+	0xca, 0xde, 0xba, 0xbd, 0x10, 0xb2, 0x90, 0x29, 0x99, 0x2b, 0xef, 0x12, 0xb5, 0x7d, 0xde, 0x67,
+	0x3c, 0x62, 0xdc, 0x8d, 0x78, 0x28, 0xcd, 0x22, 0x1e, 0x6a, 0xc5, 0x3c, 0x8a, 0x08, 0x65, 0xae,
+	0xfa, 0xd5, 0xa2, 0x0b, 0x09, 0xb6, 0x9e, 0x38, 0x49, 0x1e, 0xb4, 0xaa, 0xa4, 0xdd, 0x34, 0x10,
+	0xc7, 0xee, 0xde, 0x7a, 0x03, 0x0b, 0xb4, 0xee, 0xfa, 0x8c, 0xd0, 0x21, 0x3d, 0xbd, 0x97, 0xe9,
+	0xe5, 0x83, 0xd6, 0x9f, 0x4b, 0xf3, 0xde, 0xc7, 0x0d, 0xd4, 0x11, 0x4d, 0x6d, 0xe7, 0x7c, 0x93,
+	0x83, 0x42, 0x8d, 0x87, 0xbb, 0x98, 0x06, 0xe6, 0x4d, 0x98, 0xbb, 0x1b, 0xb3, 0xa8, 0x8e, 0x82,
+	0x20, 0xc6, 0x9c, 0x5b, 0x46, 0xd9, 0x58, 0x2d, 0x6e, 0x59, 0xbf, 0xff, 0x70, 0x6d, 0x41, 0xe7,
+	0xb2, 0x99, 0x68, 0x76, 0x45, 0x4c, 0x68, 0xe8, 0xcd, 0x4a, 0xb4, 0x16, 0x99, 0x37, 0x00, 0x04,
+	0xcb, 0x4c, 0x73, 0x13, 0x4c, 0x8b, 0x82, 0xa5, 0x86, 0x4d, 0x98, 0x41, 0x11, 0xeb, 0x50, 0x61,
+	0x4d, 0x97, 0xa7, 0x57, 0x67, 0x37, 0x2e, 0x54, 0xb4, 0x85, 0x2c, 0xb5, 0xa2, 0x4b, 0xa9, 0x6c,
+	0x33, 0x42, 0xb7, 0xde, 0x79, 0xf2, 0x6c, 0x69, 0xea, 0xfb, 0x3f, 0x97, 0x56, 0x43, 0x22, 0x9a,
+	0x9d, 0x46, 0xc5, 0x67, 0x91, 0x66, 0x49, 0x5f, 0xae, 0xf1, 0xe0, 0x9e, 0x2b, 0xee, 0xb7, 0x31,
+	0x57, 0x06, 0xfc, 0xdb, 0x97, 0x8f, 0xd7, 0x0c, 0x4f, 0xfb, 0xaf, 0xae, 0x7d, 0xf9, 0x68, 0x69,
+	0xea, 0xef, 0x47, 0x4b, 0x53, 0x9f, 0xbf, 0x7c, 0xbc, 0xd6, 0x57, 0xea, 0x57, 0x52, 0xa0, 0x18,
+	0xd2, 0x5c, 0x38, 0xf3, 0x70, 0x4a, 0xdf, 0x7a, 0x98, 0xb7, 0x19, 0xe5, 0xd8, 0xf9, 0xc9, 0x80,
+	0xb9, 0x1a, 0x0f, 0x6b, 0x9d, 0x96, 0x20, 0x8a, 0xaf, 0x5b, 0x30, 0x43, 0x68, 0xbb, 0x23, 0x24,
+	0x53, 0x32, 0x73, 0xbb, 0x9b, 0x39, 0xbd, 0x97, 0x65, 0xbe, 0x23, 0x21, 0x5b, 0x45, 0x99, 0xba,
+	0x4e, 0x27, 0x31, 0x32, 0x3f, 0x80, 0x02, 0xeb, 0x08, 0x65, 0x9f, 0x53, 0xf6, 0x17, 0x47, 0xda,
+	0x7f, 0xd4, 0x11, 0x03, 0x0e, 0x52, 0xb3, 0xea, 0xe5, 0xb4, 0x18, 0xed, 0x52, 0x96, 0x31, 0x9f,
+	0x96, 0x91, 0xe5, 0xe9, 0x9c, 0x83, 0x85, 0xde, 0xe7, 0xac, 0xa0, 0x1f, 0x0d, 0xb0, 0x54, 0x91,
+	0xe2, 0x76, 0x0b, 0x89, 0xbb, 0x2c, 0x8e, 0x6e, 0xe3, 0xd8, 0xc7, 0x54, 0xa0, 0x10, 0x9b, 0xef,
+	0x42, 0x51, 0xf6, 0x09, 0x8b, 0x89, 0xb8, 0x3f, 0xb1, 0x13, 0xba, 0x50, 0xd3, 0x85, 0x33, 0x6d,
+	0xed, 0xad, 0xde, 0xce, 0xdc, 0xa9, 0x86, 0x38, 0xe9, 0x99, 0xed, 0xa1, 0x40, 0xd5, 0xb7, 0x65,
+	0x01, 0x5d, 0x07, 0xb2, 0x86, 0xc5, 0xee, 0xab, 0x18, 0x91, 0x9a, 0xe3, 0x40, 0x79, 0x9c, 0x2e,
+	0xab, 0xed, 0xb7, 0x1c, 0x2c, 0xd6, 0x78, 0xe8, 0xe1, 0x90, 0x70, 0x81, 0xe3, 0x3b, 0xb8, 0xb1,
+	0xd9, 0x11, 0xcd, 0x0f, 0xb7, 0x63, 0x1c, 0x60, 0x2a, 0x08, 0x6a, 0x99, 0x1b, 0x50, 0x38, 0x6a,
+	0xa3, 0xa7, 0x40, 0xf3, 0x12, 0x14, 0xfd, 0x26, 0x6a, 0xb5, 0x30, 0xd5, 0x25, 0x15, 0xbd, 0xae,
+	0xc0, 0x7c, 0x1d, 0x72, 0x71, 0xdb, 0x9a, 0x56, 0xe2, 0x5c, 0xdc, 0x36, 0x4d, 0xc8, 0x07, 0x48,
+	0x20, 0x2b, 0x5f, 0x36, 0x56, 0xe7, 0x3c, 0x75, 0x6f, 0x9e, 0x81, 0x13, 0x71, 0xbb, 0x4e, 0x02,
+	0xeb, 0x84, 0x82, 0xe5, 0xe3, 0xf6, 0x4e, 0x60, 0x5a, 0x50, 0x60, 0x31, 0x09, 0x09, 0xe5, 0xd6,
+	0x4c, 0x79, 0x7a, 0xb5, 0xe8, 0xa5, 0x8f, 0xe6, 0x0e, 0x98, 0x48, 0x08, 0xcc, 0x05, 0x12, 0x84,
+	0xd1, 0x7a, 0x9b, 0xb5, 0x88, 0x7f, 0xdf, 0x2a, 0x94, 0x0d, 0xd5, 0x6e, 0x7a, 0xf2, 0x54, 0x36,
+	0xbb, 0x90, 0xdb, 0x0a, 0xe1, 0xcd, 0xa3, 0x41, 0x51, 0xf5, 0xba, 0xe4, 0xb9, 0xd0, 0xd3, 0xf0,
+	0x4e, 0xca, 0xf2, 0x78, 0x92, 0x9c, 0x00, 0x2e, 0x1f, 0x0a, 0x48, 0xf9, 0x36, 0x6f, 0x02, 0xf8,
+	0x99, 0x54, 0x11, 0x2a, 0xfb, 0x39, 0x4d, 0x50, 0x1b, 0xd2, 0x1e, 0xc3, 0x1e, 0xb8, 0xf3, 0x9d,
+	0x01, 0x17, 0x55, 0x98, 0x88, 0xed, 0xe1, 0x57, 0xf4, 0xaa, 0x56, 0xe0, 0x64, 0x37, 0x82, 0x24,
+	0x3c, 0xa7, 0xde, 0xc2, 0x5c, 0x57, 0xb8, 0x13, 0x54, 0xd7, 0x07, 0x39, 0x29, 0x77, 0x39, 0x19,
+	0x9d, 0x8b, 0x73, 0x19, 0x56, 0x0e, 0x51, 0x67, 0xfd, 0xf7, 0x4b, 0x0e, 0x96, 0x6b, 0x3c, 0x94,
+	0x6a, 0xa9, 0xf1, 0x91, 0xc0, 0xff, 0xd7, 0x1e, 0x7c, 0x1f, 0xec, 0x0e, 0xc7, 0x71, 0x7d, 0x0f,
+	0xc7, 0xe4, 0x2e, 0xf1, 0x93, 0x4e, 0x8c, 0xf1, 0x67, 0x1d, 0x12, 0xe3, 0x40, 0xf5, 0xe2, 0x6b,
+	0x9e, 0x25, 0x11, 0x1f, 0xf7, 0x00, 0x3c, 0xad, 0xaf, 0xde, 0x18, 0xa4, 0xf8, 0x4a, 0x4a, 0xf1,
+	0xe1, 0xdc, 0x38, 0x4d, 0xb8, 0x3a, 0x11, 0xf4, 0x6a, 0xda, 0xef, 0x57, 0x03, 0xce, 0x27, 0x03,
+	0x65, 0xe8, 0x20, 0x1d, 0x7b, 0x0c, 0xde, 0x82, 0x19, 0x7d, 0x58, 0x73, 0x93, 0x0e, 0x6b, 0xdf,
+	0x6e, 0x48, 0x8c, 0xaa, 0xee, 0xf0, 0x50, 0xbc, 0xd4, 0x33, 0x14, 0x87, 0x7c, 0x38, 0xcb, 0xb0,
+	0x34, 0x46, 0x95, 0xb5, 0xe4, 0xcf, 0x39, 0x30, 0xe5, 0x1e, 0x20, 0x61, 0x8c, 0x04, 0xde, 0xf4,
+	0x7d, 0xb9, 0x14, 0xf9, 0xb1, 0x2b, 0x2c, 0xc3, 0x1c, 0x6b, 0x05, 0x75, 0x9f, 0x05, 0xb8, 0x4e,
+	0x82, 0x64, 0x87, 0xe5, 0x3d, 0x60, 0xad, 0x60, 0x9b, 0x05, 0x78, 0x27, 0xe0, 0x66, 0x09, 0x66,
+	0x29, 0xde, 0x4f, 0x11, 0xaa, 0x29, 0xf3, 0x5e, 0x91, 0xe2, 0xfd, 0x04, 0x60, 0x7e, 0x0a, 0xb3,
+	0x51, 0x92, 0x4c, 0x3d, 0xe2, 0x61, 0xd2, 0xa2, 0x5b, 0xb7, 0xfe, 0x7d, 0xb6, 0xf4, 0x5e, 0xcf,
+	0x7e, 0xdf, 0x66, 0x3c, 0xba, 0x83, 0x78, 0xe4, 0xee, 0x23, 0x1e, 0x05, 0xee, 0x81, 0xba, 0xea,
+	0x1d, 0xef, 0xa1, 0xfd, 0x6d, 0x46, 0x45, 0x8c, 0x7c, 0x51, 0xc3, 0x9c, 0xcb, 0xf9, 0x0f, 0xda,
+	0x63, 0x8d, 0x87, 0xe6, 0x22, 0x40, 0x03, 0x09, 0xbf, 0x59, 0xe7, 0xe4, 0x01, 0x56, 0xcd, 0x9e,
+	0xf7, 0x8a, 0x4a, 0xb2, 0x4b, 0x1e, 0xe0, 0xea, 0xda, 0x30, 0xc7, 0xe7, 0xb3, 0xe5, 0xd9, 0x4f,
+	0x92, 0x73, 0x09, 0xec, 0x61, 0x69, 0xc6, 0xec, 0x17, 0x06, 0x5c, 0xa8, 0xf1, 0x70, 0x1b, 0x51,
+	0x1f, 0xb7, 0xb4, 0x36, 0xc1, 0x12, 0x46, 0x8f, 0x4b, 0x70, 0x32, 0x9c, 0xfa, 0xf3, 0x2b, 0xa5,
+	0xf9, 0x8d, 0x0e, 0xe5, 0xac, 0xc0, 0xf2, 0x58, 0x65, 0x9a, 0xed, 0xc6, 0x3f, 0x33, 0x30, 0x2d,
+	0xe9, 0xd9, 0x80, 0xbc, 0xfa, 0x8c, 0x39, 0x9d, 0xb5, 0xa6, 0xfe, 0xe2, 0xb1, 0xad, 0x41, 0x49,
+	0x76, 0xce, 0x36, 0xa1, 0xd8, 0xfd, 0xfe, 0x39, 0xdb, 0x0b, 0xcb, 0xc4, 0xf6, 0xe2, 0x48, 0x71,
+	0xe6, 0x02, 0xc3, 0xd9, 0xd1, 0x5f, 0x1c, 0xcb, 0xfd, 0x51, 0x47, 0x40, 0xec, 0xab, 0x13, 0x21,
+	0x59, 0x18, 0x01, 0xf6, 0x21, 0xcb, 0xff, 0x4a, 0xaf, 0xa3, 0xf1, 0x38, 0xbb, 0x72, 0x34, 0x5c,
+	0x16, 0x95, 0x82, 0x35, 0x76, 0x8b, 0xbd, 0xd1, 0xef, 0x6b, 0x34, 0xca, 0x7e, 0xeb, 0x28, 0xa8,
+	0x2c, 0xde, 0x43, 0x03, 0x4a, 0x13, 0x76, 0xcc, 0x5a, 0xaf, 0xc3, 0xc3, 0xb1, 0xf6, 0xc6, 0xd1,
+	0xb1, 0x59, 0x0a, 0x0d, 0x58, 0x18, 0x39, 0x39, 0xcb, 0x03, 0xef, 0x6a, 0x08, 0x61, 0xaf, 0x4e,
+	0x42, 0x64, 0x31, 0x76, 0xe1, 0xd4, 0xe0, 0xd8, 0xba, 0xd8, 0xd7, 0x65, 0xfd, 0x4a, 0x7b, 0xe5,
+	0x10, 0x65, 0xe6, 0xb4, 0x09, 0xe7, 0xc6, 0x9c, 0x58, 0xa7, 0xd7, 0x7c, 0x34, 0xc6, 0x5e, 0x9b,
+	0x8c, 0x49, 0x23, 0xd9, 0x27, 0x1e, 0xca, 0xe1, 0xbe, 0xb5, 0xf9, 0xe4, 0x79, 0xc9, 0x78, 0xfa,
+	0xbc, 0x64, 0xfc, 0xf5, 0xbc, 0x64, 0x7c, 0xfd, 0xa2, 0x34, 0xf5, 0xf4, 0x45, 0x69, 0xea, 0x8f,
+	0x17, 0xa5, 0xa9, 0x4f, 0xde, 0xec, 0x19, 0x78, 0x8d, 0x4e, 0x4c, 0xc5, 0xb5, 0x16, 0x6a, 0x70,
+	0x57, 0x9d, 0xf6, 0x83, 0xe4, 0xa2, 0x26, 0x5e, 0x63, 0x46, 0xfd, 0x6b, 0xbb, 0xfe, 0xdf, 0x00,
+	0x8c, 0x20, 0x0c, 0xfc, 0x87, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Send defines a method for sending coins from one account to another
+	// account.
+	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other
+	// accounts.
+	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
+	// SetPlatformPercentage defines the method for updating the platform
+	// percentage fee
+	SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error)
+	// RegisterWebAuthNCredential verifies a WebAuthn registration response and
+	// stores the resulting credential against the account
+	RegisterWebAuthNCredential(ctx context.Context, in *MsgRegisterWebAuthNCredential, opts ...grpc.CallOption) (*MsgRegisterWebAuthNCredentialResponse, error)
+	// RemoveWebAuthNCredential deletes a stored credential from the account
+	RemoveWebAuthNCredential(ctx context.Context, in *MsgRemoveWebAuthNCredential, opts ...grpc.CallOption) (*MsgRemoveWebAuthNCredentialResponse, error)
+	// AuthenticateWebAuthNCredential verifies an assertion against a stored
+	// credential and records its sign count
+	AuthenticateWebAuthNCredential(ctx context.Context, in *MsgAuthenticateWebAuthNCredential, opts ...grpc.CallOption) (*MsgAuthenticateWebAuthNCredentialResponse, error)
+	// SetAttestationPolicy defines the method for updating the attestation
+	// policy every WebAuthn registration must satisfy
+	SetAttestationPolicy(ctx context.Context, in *MsgSetAttestationPolicy, opts ...grpc.CallOption) (*MsgSetAttestationPolicyResponse, error)
+	// MigrateAccounts defines the method for migrating the abstract accounts
+	// of some code ids to a new code id
+	MigrateAccounts(ctx context.Context, in *MsgMigrateAccounts, opts ...grpc.CallOption) (*MsgMigrateAccountsResponse, error)
+	// CancelAccountMigration defines the method for stopping the abstract
+	// account migration in progress
+	CancelAccountMigration(ctx context.Context, in *MsgCancelAccountMigration, opts ...grpc.CallOption) (*MsgCancelAccountMigrationResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
+	out := new(MsgSendResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/Send", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error) {
+	out := new(MsgMultiSendResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/MultiSend", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetPlatformPercentage(ctx context.Context, in *MsgSetPlatformPercentage, opts ...grpc.CallOption) (*MsgSetPlatformPercentageResponse, error) {
+	out := new(MsgSetPlatformPercentageResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetPlatformPercentage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiSend) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RegisterWebAuthNCredential(ctx context.Context, in *MsgRegisterWebAuthNCredential, opts ...grpc.CallOption) (*MsgRegisterWebAuthNCredentialResponse, error) {
+	out := new(MsgRegisterWebAuthNCredentialResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/RegisterWebAuthNCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) RemoveWebAuthNCredential(ctx context.Context, in *MsgRemoveWebAuthNCredential, opts ...grpc.CallOption) (*MsgRemoveWebAuthNCredentialResponse, error) {
+	out := new(MsgRemoveWebAuthNCredentialResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/RemoveWebAuthNCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AuthenticateWebAuthNCredential(ctx context.Context, in *MsgAuthenticateWebAuthNCredential, opts ...grpc.CallOption) (*MsgAuthenticateWebAuthNCredentialResponse, error) {
+	out := new(MsgAuthenticateWebAuthNCredentialResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/AuthenticateWebAuthNCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAttestationPolicy(ctx context.Context, in *MsgSetAttestationPolicy, opts ...grpc.CallOption) (*MsgSetAttestationPolicyResponse, error) {
+	out := new(MsgSetAttestationPolicyResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/SetAttestationPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateAccounts(ctx context.Context, in *MsgMigrateAccounts, opts ...grpc.CallOption) (*MsgMigrateAccountsResponse, error) {
+	out := new(MsgMigrateAccountsResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/MigrateAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAccountMigration(ctx context.Context, in *MsgCancelAccountMigration, opts ...grpc.CallOption) (*MsgCancelAccountMigrationResponse, error) {
+	out := new(MsgCancelAccountMigrationResponse)
+	err := c.cc.Invoke(ctx, "/xion.v1.Msg/CancelAccountMigration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Send defines a method for sending coins from one account to another
+	// account.
+	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// MultiSend defines a method for sending coins from some accounts to other
+	// accounts.
+	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+	// SetPlatformPercentage defines the method for updating the platform
+	// percentage fee
+	SetPlatformPercentage(context.Context, *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error)
+	// RegisterWebAuthNCredential verifies a WebAuthn registration response and
+	// stores the resulting credential against the account
+	RegisterWebAuthNCredential(context.Context, *MsgRegisterWebAuthNCredential) (*MsgRegisterWebAuthNCredentialResponse, error)
+	// RemoveWebAuthNCredential deletes a stored credential from the account
+	RemoveWebAuthNCredential(context.Context, *MsgRemoveWebAuthNCredential) (*MsgRemoveWebAuthNCredentialResponse, error)
+	// AuthenticateWebAuthNCredential verifies an assertion against a stored
+	// credential and records its sign count
+	AuthenticateWebAuthNCredential(context.Context, *MsgAuthenticateWebAuthNCredential) (*MsgAuthenticateWebAuthNCredentialResponse, error)
+	// SetAttestationPolicy defines the method for updating the attestation
+	// policy every WebAuthn registration must satisfy
+	SetAttestationPolicy(context.Context, *MsgSetAttestationPolicy) (*MsgSetAttestationPolicyResponse, error)
+	// MigrateAccounts defines the method for migrating the abstract accounts
+	// of some code ids to a new code id
+	MigrateAccounts(context.Context, *MsgMigrateAccounts) (*MsgMigrateAccountsResponse, error)
+	// CancelAccountMigration defines the method for stopping the abstract
+	// account migration in progress
+	CancelAccountMigration(context.Context, *MsgCancelAccountMigration) (*MsgCancelAccountMigrationResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
+}
+func (*UnimplementedMsgServer) SetPlatformPercentage(ctx context.Context, req *MsgSetPlatformPercentage) (*MsgSetPlatformPercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPlatformPercentage not implemented")
+}
+func (*UnimplementedMsgServer) RegisterWebAuthNCredential(ctx context.Context, req *MsgRegisterWebAuthNCredential) (*MsgRegisterWebAuthNCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebAuthNCredential not implemented")
+}
+func (*UnimplementedMsgServer) RemoveWebAuthNCredential(ctx context.Context, req *MsgRemoveWebAuthNCredential) (*MsgRemoveWebAuthNCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebAuthNCredential not implemented")
+}
+func (*UnimplementedMsgServer) AuthenticateWebAuthNCredential(ctx context.Context, req *MsgAuthenticateWebAuthNCredential) (*MsgAuthenticateWebAuthNCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateWebAuthNCredential not implemented")
+}
+func (*UnimplementedMsgServer) SetAttestationPolicy(ctx context.Context, req *MsgSetAttestationPolicy) (*MsgSetAttestationPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttestationPolicy not implemented")
+}
+func (*UnimplementedMsgServer) MigrateAccounts(ctx context.Context, req *MsgMigrateAccounts) (*MsgMigrateAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAccounts not implemented")
+}
+func (*UnimplementedMsgServer) CancelAccountMigration(ctx context.Context, req *MsgCancelAccountMigration) (*MsgCancelAccountMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountMigration not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/MultiSend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSend(ctx, req.(*MsgMultiSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPlatformPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPlatformPercentage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPlatformPercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetPlatformPercentage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPlatformPercentage(ctx, req.(*MsgSetPlatformPercentage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterWebAuthNCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterWebAuthNCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterWebAuthNCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/RegisterWebAuthNCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterWebAuthNCredential(ctx, req.(*MsgRegisterWebAuthNCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveWebAuthNCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveWebAuthNCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveWebAuthNCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/RemoveWebAuthNCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveWebAuthNCredential(ctx, req.(*MsgRemoveWebAuthNCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AuthenticateWebAuthNCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAuthenticateWebAuthNCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AuthenticateWebAuthNCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/AuthenticateWebAuthNCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AuthenticateWebAuthNCredential(ctx, req.(*MsgAuthenticateWebAuthNCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAttestationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttestationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttestationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/SetAttestationPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttestationPolicy(ctx, req.(*MsgSetAttestationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAccounts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/MigrateAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAccounts(ctx, req.(*MsgMigrateAccounts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAccountMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAccountMigration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAccountMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xion.v1.Msg/CancelAccountMigration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAccountMigration(ctx, req.(*MsgCancelAccountMigration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xion.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _Msg_Send_Handler,
+		},
+		{
+			MethodName: "MultiSend",
+			Handler:    _Msg_MultiSend_Handler,
+		},
+		{
+			MethodName: "SetPlatformPercentage",
+			Handler:    _Msg_SetPlatformPercentage_Handler,
+		},
+		{
+			MethodName: "RegisterWebAuthNCredential",
+			Handler:    _Msg_RegisterWebAuthNCredential_Handler,
+		},
+		{
+			MethodName: "RemoveWebAuthNCredential",
+			Handler:    _Msg_RemoveWebAuthNCredential_Handler,
+		},
+		{
+			MethodName: "AuthenticateWebAuthNCredential",
+			Handler:    _Msg_AuthenticateWebAuthNCredential_Handler,
+		},
+		{
+			MethodName: "SetAttestationPolicy",
+			Handler:    _Msg_SetAttestationPolicy_Handler,
+		},
+		{
+			MethodName: "MigrateAccounts",
+			Handler:    _Msg_MigrateAccounts_Handler,
+		},
+		{
+			MethodName: "CancelAccountMigration",
+			Handler:    _Msg_CancelAccountMigration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "xion/v1/tx.proto",
+}
+
+func (m *MsgSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformPercentage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformPercentage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformPercentage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlatformPercentage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PlatformPercentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPlatformPercentageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPlatformPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPlatformPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterWebAuthNCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterWebAuthNCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterWebAuthNCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationPolicy != nil {
+		{
+			size, err := m.AttestationPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Origins[iNdEx])
+			copy(dAtA[i:], m.Origins[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Origins[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RpId) > 0 {
+		i -= len(m.RpId)
+		copy(dAtA[i:], m.RpId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RpId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterWebAuthNCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterWebAuthNCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterWebAuthNCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credential != nil {
+		{
+			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWebAuthNCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWebAuthNCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWebAuthNCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialId) > 0 {
+		i -= len(m.CredentialId)
+		copy(dAtA[i:], m.CredentialId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWebAuthNCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWebAuthNCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWebAuthNCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserVerificationRequired {
+		i--
+		if m.UserVerificationRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Origins) > 0 {
+		for iNdEx := len(m.Origins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Origins[iNdEx])
+			copy(dAtA[i:], m.Origins[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Origins[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RpId) > 0 {
+		i -= len(m.RpId)
+		copy(dAtA[i:], m.RpId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RpId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Rp) > 0 {
+		i -= len(m.Rp)
+		copy(dAtA[i:], m.Rp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAuthenticateWebAuthNCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAuthenticateWebAuthNCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAuthenticateWebAuthNCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credential != nil {
+		{
+			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttestationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttestationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttestationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAttestationPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAttestationPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttestationPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MigrateMsg) > 0 {
+		i -= len(m.MigrateMsg)
+		copy(dAtA[i:], m.MigrateMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateMsg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewCodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewCodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OldCodeIds) > 0 {
+		dAtA6 := make([]byte, len(m.OldCodeIds)*10)
+		var j5 int
+		for _, num := range m.OldCodeIds {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAccountMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAccountMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAccountMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAccountMigrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAccountMigrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAccountMigrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMultiSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPlatformPercentage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlatformPercentage != 0 {
+		n += 1 + sovTx(uint64(m.PlatformPercentage))
+	}
+	return n
+}
+
+func (m *MsgSetPlatformPercentageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterWebAuthNCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RpId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Origins) > 0 {
+		for _, s := range m.Origins {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AttestationPolicy != nil {
+		l = m.AttestationPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterWebAuthNCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credential != nil {
+		l = m.Credential.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveWebAuthNCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CredentialId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveWebAuthNCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAuthenticateWebAuthNCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Rp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RpId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Origins) > 0 {
+		for _, s := range m.Origins {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UserVerificationRequired {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAuthenticateWebAuthNCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credential != nil {
+		l = m.Credential.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAttestationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAttestationPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.OldCodeIds) > 0 {
+		l = 0
+		for _, e := range m.OldCodeIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.NewCodeId != 0 {
+		n += 1 + sovTx(uint64(m.NewCodeId))
+	}
+	l = len(m.MigrateMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovTx(uint64(m.BatchSize))
+	}
+	return n
+}
+
+func (m *MsgMigrateAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelAccountMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAccountMigrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, types1.Input{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, types1.Output{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
+			}
+			m.PlatformPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlatformPercentage |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterWebAuthNCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterWebAuthNCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterWebAuthNCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttestationPolicy == nil {
+				m.AttestationPolicy = &AttestationPolicy{}
+			}
+			if err := m.AttestationPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterWebAuthNCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterWebAuthNCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterWebAuthNCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credential == nil {
+				m.Credential = &WebAuthnCredential{}
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveWebAuthNCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWebAuthNCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWebAuthNCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialId = append(m.CredentialId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CredentialId == nil {
+				m.CredentialId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveWebAuthNCredentialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWebAuthNCredentialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWebAuthNCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAuthenticateWebAuthNCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAuthenticateWebAuthNCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAuthenticateWebAuthNCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origins = append(m.Origins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVerificationRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserVerificationRequired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgMultiSendResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetPlatformPercentage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PlatformPercentage != 0 {
-		n += 1 + sovTx(uint64(m.PlatformPercentage))
-	}
-	return n
-}
 
-func (m *MsgSetPlatformPercentageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSend) Unmarshal(dAtA []byte) error {
+func (m *MsgAuthenticateWebAuthNCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,17 +3825,17 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAuthenticateWebAuthNCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAuthenticateWebAuthNCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -862,27 +3845,81 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			if m.Credential == nil {
+				m.Credential = &WebAuthnCredential{}
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAttestationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAttestationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAttestationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -910,11 +3947,11 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -941,8 +3978,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -967,7 +4003,7 @@ func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttestationPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -990,10 +4026,10 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttestationPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttestationPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1017,7 +4053,7 @@ func (m *MsgSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAccounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1040,17 +4076,17 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAccounts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1060,31 +4096,124 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, types1.Input{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OldCodeIds = append(m.OldCodeIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OldCodeIds) == 0 {
+					m.OldCodeIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OldCodeIds = append(m.OldCodeIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldCodeIds", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeId", wireType)
+			}
+			m.NewCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsg", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1094,26 +4223,45 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, types1.Output{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MigrateMsg = append(m.MigrateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.MigrateMsg == nil {
+				m.MigrateMsg = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1135,7 +4283,7 @@ func (m *MsgMultiSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1158,10 +4306,10 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1185,7 +4333,7 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAccountMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1208,10 +4356,10 @@ func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAccountMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAccountMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1246,25 +4394,6 @@ func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlatformPercentage", wireType)
-			}
-			m.PlatformPercentage = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PlatformPercentage |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1286,7 +4415,7 @@ func (m *MsgSetPlatformPercentage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelAccountMigrationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1309,10 +4438,10 @@ func (m *MsgSetPlatformPercentageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelAccountMigrationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetPlatformPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelAccountMigrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
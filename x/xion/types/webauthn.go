@@ -1,10 +1,13 @@
 package types
 
 import (
-	"net/url"
+	"bytes"
+	"encoding/json"
 
 	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 type SmartContractUser struct {
@@ -34,19 +37,16 @@ func (s SmartContractUser) WebAuthnIcon() string {
 
 var _ webauthn.User = SmartContractUser{}
 
-func VerifyRegistration(rp *url.URL, contractAddr string, challenge string, credentialCreationData *protocol.ParsedCredentialCreationData) (*webauthn.Credential, error) {
-	config := webauthn.Config{
-		RPID:                   rp.Host,
-		RPDisplayName:          rp.String(),
-		RPOrigins:              []string{rp.String()},
-		AttestationPreference:  "",
-		AuthenticatorSelection: protocol.AuthenticatorSelection{},
-	}
-	webAuthn, err := webauthn.New(&config)
+func VerifyRegistration(rp *RelyingParty, contractAddr string, challenge string, credentialCreationData *protocol.ParsedCredentialCreationData) (*webauthn.Credential, error) {
+	webAuthn, err := webauthn.New(rp.Config())
 	if err != nil {
 		return nil, err
 	}
 
+	if err := rp.verifyTopOrigin(credentialCreationData.Raw.AttestationResponse.ClientDataJSON); err != nil {
+		return nil, err
+	}
+
 	smartContractUser := SmartContractUser{Address: contractAddr}
 	session := webauthn.SessionData{
 		Challenge:        challenge,
@@ -57,17 +57,25 @@ func VerifyRegistration(rp *url.URL, contractAddr string, challenge string, cred
 	return webAuthn.CreateCredential(smartContractUser, session, credentialCreationData)
 }
 
-func VerifyAuthentication(rp *url.URL, contractAddr string, challenge string, credential *webauthn.Credential, credentialAssertionData *protocol.ParsedCredentialAssertionData) (bool, error) {
-	config := webauthn.Config{
-		RPID:                   rp.Host,
-		RPDisplayName:          rp.String(),
-		RPOrigins:              []string{rp.String()},
-		AttestationPreference:  "",
-		AuthenticatorSelection: protocol.AuthenticatorSelection{},
+func VerifyAuthentication(rp *RelyingParty, contractAddr string, challenge string, credential *webauthn.Credential, credentialAssertionData *protocol.ParsedCredentialAssertionData) (bool, error) {
+	if _, err := ValidateAuthentication(rp, contractAddr, challenge, credential, credentialAssertionData, protocol.VerificationPreferred); err != nil {
+		return false, err
 	}
-	webAuthn, err := webauthn.New(&config)
+
+	return true, nil
+}
+
+// ValidateAuthentication verifies an assertion against a credential and
+// returns the credential updated with the assertion's flags and sign count.
+// A sign count that does not increase sets the clone warning.
+func ValidateAuthentication(rp *RelyingParty, contractAddr string, challenge string, credential *webauthn.Credential, credentialAssertionData *protocol.ParsedCredentialAssertionData, userVerification protocol.UserVerificationRequirement) (*webauthn.Credential, error) {
+	webAuthn, err := webauthn.New(rp.Config())
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	if err := rp.verifyTopOrigin(credentialAssertionData.Raw.AssertionResponse.ClientDataJSON); err != nil {
+		return nil, err
 	}
 
 	smartContractUser := SmartContractUser{
@@ -77,13 +85,124 @@ func VerifyAuthentication(rp *url.URL, contractAddr string, challenge string, cr
 	session := webauthn.SessionData{
 		Challenge:            challenge,
 		UserID:               smartContractUser.WebAuthnID(),
-		UserVerification:     protocol.VerificationPreferred,
+		UserVerification:     userVerification,
 		AllowedCredentialIDs: [][]byte{credential.ID},
 	}
 
-	if _, err := webAuthn.ValidateLogin(smartContractUser, session, credentialAssertionData); err != nil {
-		return false, err
+	return webAuthn.ValidateLogin(smartContractUser, session, credentialAssertionData)
+}
+
+// WebAuthnCredentialVersion is the current version of the WebAuthnCredential
+// encoding
+const WebAuthnCredentialVersion = 1
+
+// NewWebAuthnCredential converts a verified webauthn credential into its
+// stored representation
+func NewWebAuthnCredential(credential *webauthn.Credential) *WebAuthnCredential {
+	var transports []string
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
 	}
 
-	return true, nil
+	return &WebAuthnCredential{
+		Id:              credential.ID,
+		PublicKey:       credential.PublicKey,
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		UserPresent:     credential.Flags.UserPresent,
+		UserVerified:    credential.Flags.UserVerified,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+		Aaguid:          credential.Authenticator.AAGUID,
+		SignCount:       credential.Authenticator.SignCount,
+		CloneWarning:    credential.Authenticator.CloneWarning,
+		Attachment:      string(credential.Authenticator.Attachment),
+		Version:         WebAuthnCredentialVersion,
+	}
+}
+
+// DecodeWebAuthnCredential decodes a protobuf encoded credential. Credentials
+// returned before the protobuf encoding existed are the JSON encoding of the
+// go-webauthn credential and are converted.
+func DecodeWebAuthnCredential(bz []byte) (*WebAuthnCredential, error) {
+	// a protobuf encoded credential never starts with '{', which would be
+	// the tag of a group in field 15. Only the first byte is checked, the
+	// ones after it are field data that may be anything.
+	if len(bz) > 0 && bz[0] == '{' {
+		var legacy webauthn.Credential
+		if err := json.Unmarshal(bz, &legacy); err != nil {
+			return nil, errorsmod.Wrap(ErrInvalidWebAuthnData, err.Error())
+		}
+		return NewWebAuthnCredential(&legacy), nil
+	}
+
+	var credential WebAuthnCredential
+	if err := credential.Unmarshal(bz); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidWebAuthnData, err.Error())
+	}
+	if credential.Version != WebAuthnCredentialVersion {
+		return nil, errorsmod.Wrapf(ErrInvalidWebAuthnData, "unsupported credential version %d", credential.Version)
+	}
+
+	return &credential, nil
+}
+
+// ToCredential converts the stored credential back into a webauthn credential
+func (c WebAuthnCredential) ToCredential() *webauthn.Credential {
+	var transports []protocol.AuthenticatorTransport
+	for _, transport := range c.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(transport))
+	}
+
+	return &webauthn.Credential{
+		ID:              c.Id,
+		PublicKey:       c.PublicKey,
+		AttestationType: c.AttestationType,
+		Transport:       transports,
+		Flags: webauthn.CredentialFlags{
+			UserPresent:    c.UserPresent,
+			UserVerified:   c.UserVerified,
+			BackupEligible: c.BackupEligible,
+			BackupState:    c.BackupState,
+		},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:       c.Aaguid,
+			SignCount:    c.SignCount,
+			CloneWarning: c.CloneWarning,
+			Attachment:   protocol.AuthenticatorAttachment(c.Attachment),
+		},
+	}
+}
+
+// SelectCredential returns the index of the credential an assertion was
+// signed with
+func SelectCredential(credentials []webauthn.Credential, credentialAssertionData *protocol.ParsedCredentialAssertionData) (int, error) {
+	for i, credential := range credentials {
+		if bytes.Equal(credential.ID, credentialAssertionData.RawID) {
+			return i, nil
+		}
+	}
+
+	return 0, protocol.ErrBadRequest.WithDetails("Unable to find the credential for the returned credential ID")
+}
+
+// UserVerification returns the user verification requirement of a request
+func UserVerification(required bool) protocol.UserVerificationRequirement {
+	if required {
+		return protocol.VerificationRequired
+	}
+	return protocol.VerificationPreferred
+}
+
+// NewQueryWebAuthNVerifyAuthenticateResponse describes a verified assertion
+func NewQueryWebAuthNVerifyAuthenticateResponse(data *protocol.ParsedCredentialAssertionData) *QueryWebAuthNVerifyAuthenticateResponse {
+	authData := data.Response.AuthenticatorData
+	return &QueryWebAuthNVerifyAuthenticateResponse{
+		CredentialId:   data.RawID,
+		UserPresent:    authData.Flags.HasUserPresent(),
+		UserVerified:   authData.Flags.HasUserVerified(),
+		BackupEligible: authData.Flags.HasBackupEligible(),
+		BackupState:    authData.Flags.HasBackupState(),
+		SignCount:      authData.Counter,
+	}
 }
@@ -0,0 +1,1623 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: xion/v1/webauthn.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WebAuthnCredential is a verified WebAuthn public key credential. Its
+// encoding replaces the JSON of the go-webauthn credential struct.
+type WebAuthnCredential struct {
+	// id is the raw credential ID chosen by the authenticator
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// public_key is the COSE encoded credential public key
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// attestation_type is the attestation statement format used at registration
+	AttestationType string   `protobuf:"bytes,3,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
+	Transports      []string `protobuf:"bytes,4,rep,name=transports,proto3" json:"transports,omitempty"`
+	UserPresent     bool     `protobuf:"varint,5,opt,name=user_present,json=userPresent,proto3" json:"user_present,omitempty"`
+	UserVerified    bool     `protobuf:"varint,6,opt,name=user_verified,json=userVerified,proto3" json:"user_verified,omitempty"`
+	BackupEligible  bool     `protobuf:"varint,7,opt,name=backup_eligible,json=backupEligible,proto3" json:"backup_eligible,omitempty"`
+	BackupState     bool     `protobuf:"varint,8,opt,name=backup_state,json=backupState,proto3" json:"backup_state,omitempty"`
+	Aaguid          []byte   `protobuf:"bytes,9,opt,name=aaguid,proto3" json:"aaguid,omitempty"`
+	SignCount       uint32   `protobuf:"varint,10,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	CloneWarning    bool     `protobuf:"varint,11,opt,name=clone_warning,json=cloneWarning,proto3" json:"clone_warning,omitempty"`
+	Attachment      string   `protobuf:"bytes,12,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// version of the credential encoding
+	Version uint32 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *WebAuthnCredential) Reset()         { *m = WebAuthnCredential{} }
+func (m *WebAuthnCredential) String() string { return proto.CompactTextString(m) }
+func (*WebAuthnCredential) ProtoMessage()    {}
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e692221f242b60f, []int{0}
+}
+func (m *WebAuthnCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebAuthnCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebAuthnCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebAuthnCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebAuthnCredential.Merge(m, src)
+}
+func (m *WebAuthnCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebAuthnCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebAuthnCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebAuthnCredential proto.InternalMessageInfo
+
+func (m *WebAuthnCredential) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *WebAuthnCredential) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *WebAuthnCredential) GetAttestationType() string {
+	if m != nil {
+		return m.AttestationType
+	}
+	return ""
+}
+
+func (m *WebAuthnCredential) GetTransports() []string {
+	if m != nil {
+		return m.Transports
+	}
+	return nil
+}
+
+func (m *WebAuthnCredential) GetUserPresent() bool {
+	if m != nil {
+		return m.UserPresent
+	}
+	return false
+}
+
+func (m *WebAuthnCredential) GetUserVerified() bool {
+	if m != nil {
+		return m.UserVerified
+	}
+	return false
+}
+
+func (m *WebAuthnCredential) GetBackupEligible() bool {
+	if m != nil {
+		return m.BackupEligible
+	}
+	return false
+}
+
+func (m *WebAuthnCredential) GetBackupState() bool {
+	if m != nil {
+		return m.BackupState
+	}
+	return false
+}
+
+func (m *WebAuthnCredential) GetAaguid() []byte {
+	if m != nil {
+		return m.Aaguid
+	}
+	return nil
+}
+
+func (m *WebAuthnCredential) GetSignCount() uint32 {
+	if m != nil {
+		return m.SignCount
+	}
+	return 0
+}
+
+func (m *WebAuthnCredential) GetCloneWarning() bool {
+	if m != nil {
+		return m.CloneWarning
+	}
+	return false
+}
+
+func (m *WebAuthnCredential) GetAttachment() string {
+	if m != nil {
+		return m.Attachment
+	}
+	return ""
+}
+
+func (m *WebAuthnCredential) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+// WebAuthnCredentialRecord binds a stored credential to its account
+type WebAuthnCredentialRecord struct {
+	Address    string              `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Credential *WebAuthnCredential `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"`
+}
+
+func (m *WebAuthnCredentialRecord) Reset()         { *m = WebAuthnCredentialRecord{} }
+func (m *WebAuthnCredentialRecord) String() string { return proto.CompactTextString(m) }
+func (*WebAuthnCredentialRecord) ProtoMessage()    {}
+func (*WebAuthnCredentialRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e692221f242b60f, []int{1}
+}
+func (m *WebAuthnCredentialRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebAuthnCredentialRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebAuthnCredentialRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebAuthnCredentialRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebAuthnCredentialRecord.Merge(m, src)
+}
+func (m *WebAuthnCredentialRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebAuthnCredentialRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebAuthnCredentialRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebAuthnCredentialRecord proto.InternalMessageInfo
+
+func (m *WebAuthnCredentialRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *WebAuthnCredentialRecord) GetCredential() *WebAuthnCredential {
+	if m != nil {
+		return m.Credential
+	}
+	return nil
+}
+
+// AttestationPolicy restricts the authenticators that may register a
+// credential
+type AttestationPolicy struct {
+	// formats are the accepted attestation statement formats, such as packed,
+	// tpm, android-key or apple. Empty accepts any format.
+	Formats []string `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty"`
+	// aaguids are the accepted authenticator models. Empty accepts any model.
+	Aaguids [][]byte `protobuf:"bytes,2,rep,name=aaguids,proto3" json:"aaguids,omitempty"`
+	// trust_anchors are the DER encoded root certificates the attestation
+	// certificate chain must verify against. Empty skips chain verification.
+	TrustAnchors [][]byte `protobuf:"bytes,3,rep,name=trust_anchors,json=trustAnchors,proto3" json:"trust_anchors,omitempty"`
+}
+
+func (m *AttestationPolicy) Reset()         { *m = AttestationPolicy{} }
+func (m *AttestationPolicy) String() string { return proto.CompactTextString(m) }
+func (*AttestationPolicy) ProtoMessage()    {}
+func (*AttestationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e692221f242b60f, []int{2}
+}
+func (m *AttestationPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPolicy.Merge(m, src)
+}
+func (m *AttestationPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPolicy proto.InternalMessageInfo
+
+func (m *AttestationPolicy) GetFormats() []string {
+	if m != nil {
+		return m.Formats
+	}
+	return nil
+}
+
+func (m *AttestationPolicy) GetAaguids() [][]byte {
+	if m != nil {
+		return m.Aaguids
+	}
+	return nil
+}
+
+func (m *AttestationPolicy) GetTrustAnchors() [][]byte {
+	if m != nil {
+		return m.TrustAnchors
+	}
+	return nil
+}
+
+// WebAuthnChallengeBinding binds a WebAuthn challenge to a transaction. The
+// challenge must equal the one derived from the chain ID, the account number
+// of the verified address, sequence and sign_doc_hash.
+type WebAuthnChallengeBinding struct {
+	// sign_doc_hash is the SHA-256 hash of the transaction's sign bytes
+	SignDocHash []byte `protobuf:"bytes,1,opt,name=sign_doc_hash,json=signDocHash,proto3" json:"sign_doc_hash,omitempty"`
+	// sequence is the account sequence the transaction is signed with, it must
+	// be the current sequence of the verified address
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *WebAuthnChallengeBinding) Reset()         { *m = WebAuthnChallengeBinding{} }
+func (m *WebAuthnChallengeBinding) String() string { return proto.CompactTextString(m) }
+func (*WebAuthnChallengeBinding) ProtoMessage()    {}
+func (*WebAuthnChallengeBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e692221f242b60f, []int{3}
+}
+func (m *WebAuthnChallengeBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebAuthnChallengeBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebAuthnChallengeBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebAuthnChallengeBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebAuthnChallengeBinding.Merge(m, src)
+}
+func (m *WebAuthnChallengeBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebAuthnChallengeBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebAuthnChallengeBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebAuthnChallengeBinding proto.InternalMessageInfo
+
+func (m *WebAuthnChallengeBinding) GetSignDocHash() []byte {
+	if m != nil {
+		return m.SignDocHash
+	}
+	return nil
+}
+
+func (m *WebAuthnChallengeBinding) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*WebAuthnCredential)(nil), "xion.v1.WebAuthnCredential")
+	proto.RegisterType((*WebAuthnCredentialRecord)(nil), "xion.v1.WebAuthnCredentialRecord")
+	proto.RegisterType((*AttestationPolicy)(nil), "xion.v1.AttestationPolicy")
+	proto.RegisterType((*WebAuthnChallengeBinding)(nil), "xion.v1.WebAuthnChallengeBinding")
+}
+
+func init() { proto.RegisterFile("xion/v1/webauthn.proto", fileDescriptor_9e692221f242b60f) }
+
+var fileDescriptor_9e692221f242b60f = []byte{
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x97, 0x76, 0xbf, 0x75, 0x75, 0xdb, 0xed, 0x87, 0x85, 0x26, 0x33, 0x44, 0x54, 0xca,
+	0x61, 0xe1, 0xb0, 0x56, 0x1b, 0x47, 0x4e, 0xdd, 0x40, 0x42, 0xe2, 0x32, 0x65, 0x88, 0x49, 0xbb,
+	0x44, 0x8e, 0xf3, 0x2c, 0xb1, 0x96, 0xda, 0xc1, 0x76, 0xba, 0xf5, 0x35, 0x70, 0xe1, 0xc5, 0x70,
+	0xe0, 0x25, 0x70, 0x9c, 0x38, 0x71, 0x44, 0xeb, 0x1b, 0x41, 0xb6, 0xd3, 0x51, 0x09, 0x4e, 0xd1,
+	0xf3, 0xf1, 0xd7, 0x79, 0xfe, 0x7c, 0x1f, 0xa3, 0xbd, 0x5b, 0x2e, 0xc5, 0x64, 0x7e, 0x34, 0xb9,
+	0x81, 0x94, 0xd6, 0xa6, 0x10, 0xe3, 0x4a, 0x49, 0x23, 0x71, 0xc7, 0xf2, 0xf1, 0xfc, 0x68, 0xff,
+	0x09, 0x93, 0x7a, 0x26, 0x75, 0xe2, 0xf0, 0xc4, 0x07, 0x5e, 0x33, 0xfa, 0xd6, 0x46, 0xf8, 0x02,
+	0xd2, 0xa9, 0xbd, 0x76, 0xaa, 0x20, 0x03, 0x61, 0x38, 0x2d, 0xf1, 0x0e, 0x6a, 0xf1, 0x8c, 0x04,
+	0xc3, 0x20, 0xea, 0xc7, 0x2d, 0x9e, 0xe1, 0x67, 0x08, 0x55, 0x75, 0x5a, 0x72, 0x96, 0x5c, 0xc3,
+	0x82, 0xb4, 0x1c, 0xef, 0x7a, 0xf2, 0x1e, 0x16, 0xf8, 0x25, 0xfa, 0x9f, 0x1a, 0x03, 0xda, 0x50,
+	0xc3, 0xa5, 0x48, 0xcc, 0xa2, 0x02, 0xd2, 0x1e, 0x06, 0x51, 0x37, 0xde, 0x5d, 0xe3, 0x1f, 0x16,
+	0x15, 0xe0, 0x10, 0x21, 0xa3, 0xa8, 0xd0, 0x95, 0x54, 0x46, 0x93, 0xcd, 0x61, 0x3b, 0xea, 0xc6,
+	0x6b, 0x04, 0x3f, 0x47, 0xfd, 0x5a, 0x83, 0x4a, 0x2a, 0x05, 0x1a, 0x84, 0x21, 0xff, 0x0d, 0x83,
+	0x68, 0x3b, 0xee, 0x59, 0x76, 0xe6, 0x11, 0x7e, 0x81, 0x06, 0x4e, 0x32, 0x07, 0xc5, 0xaf, 0x38,
+	0x64, 0x64, 0xcb, 0x69, 0xdc, 0xbd, 0x8f, 0x0d, 0xc3, 0x07, 0x68, 0x37, 0xa5, 0xec, 0xba, 0xae,
+	0x12, 0x28, 0x79, 0xce, 0xd3, 0x12, 0x48, 0xc7, 0xc9, 0x76, 0x3c, 0x7e, 0xdb, 0x50, 0x9b, 0xb0,
+	0x11, 0xda, 0x32, 0x81, 0x6c, 0xfb, 0x84, 0x9e, 0x9d, 0x5b, 0x84, 0xf7, 0xd0, 0x16, 0xa5, 0x79,
+	0xcd, 0x33, 0xd2, 0x75, 0x9d, 0x37, 0x91, 0x9d, 0x8a, 0xe6, 0xb9, 0x48, 0x98, 0xac, 0x85, 0x21,
+	0x68, 0x18, 0x44, 0x83, 0xb8, 0x6b, 0xc9, 0xa9, 0xac, 0x7d, 0x9d, 0xac, 0x94, 0x02, 0x92, 0x1b,
+	0xaa, 0x04, 0x17, 0x39, 0xe9, 0xf9, 0x3a, 0x1d, 0xbc, 0xf0, 0xcc, 0xce, 0x83, 0x1a, 0x43, 0x59,
+	0x31, 0xb3, 0xdd, 0xf6, 0xdd, 0xd0, 0xd6, 0x08, 0x26, 0xa8, 0x33, 0x07, 0xa5, 0xb9, 0x14, 0x64,
+	0xe0, 0x12, 0xac, 0xc2, 0xd1, 0xe7, 0x00, 0x91, 0xbf, 0xad, 0x8b, 0x81, 0x49, 0x95, 0xe1, 0x63,
+	0xd4, 0xa1, 0x59, 0xa6, 0x40, 0x6b, 0xe7, 0x62, 0xf7, 0x84, 0xfc, 0xf8, 0x7a, 0xf8, 0xb8, 0xb1,
+	0x7e, 0xea, 0x4f, 0xce, 0x8d, 0xe2, 0x22, 0x8f, 0x57, 0x42, 0xfc, 0x1a, 0x21, 0xf6, 0xf0, 0x1f,
+	0x67, 0x72, 0xef, 0xf8, 0xe9, 0xb8, 0x59, 0xa2, 0xf1, 0x3f, 0x52, 0xad, 0xc9, 0x47, 0x25, 0x7a,
+	0x34, 0xfd, 0x63, 0xf5, 0x99, 0x2c, 0x39, 0x5b, 0xd8, 0xe2, 0xaf, 0xa4, 0x9a, 0x51, 0x63, 0xab,
+	0xb0, 0x4e, 0xaf, 0x42, 0x7b, 0xe2, 0x87, 0xa8, 0x49, 0x6b, 0xd8, 0x8e, 0xfa, 0xf1, 0x2a, 0xb4,
+	0x53, 0x33, 0xaa, 0xd6, 0x26, 0xa1, 0x82, 0x15, 0x52, 0x69, 0xd2, 0x76, 0xe7, 0x7d, 0x07, 0xa7,
+	0x9e, 0x8d, 0x2e, 0xd7, 0x5a, 0x2f, 0x68, 0x59, 0x82, 0xc8, 0xe1, 0x84, 0x8b, 0xcc, 0x4e, 0x74,
+	0x84, 0x06, 0xce, 0x95, 0x4c, 0xb2, 0xa4, 0xa0, 0xba, 0x68, 0xd6, 0xb8, 0x67, 0xe1, 0x1b, 0xc9,
+	0xde, 0x51, 0x5d, 0xe0, 0x7d, 0xb4, 0xad, 0xe1, 0x53, 0x0d, 0x82, 0x81, 0x6b, 0x74, 0x33, 0x7e,
+	0x88, 0x4f, 0xa6, 0xdf, 0xef, 0xc3, 0xe0, 0xee, 0x3e, 0x0c, 0x7e, 0xdd, 0x87, 0xc1, 0x97, 0x65,
+	0xb8, 0x71, 0xb7, 0x0c, 0x37, 0x7e, 0x2e, 0xc3, 0x8d, 0xcb, 0x83, 0x9c, 0x9b, 0xa2, 0x4e, 0xc7,
+	0x4c, 0xce, 0x26, 0x69, 0xad, 0x84, 0x39, 0x2c, 0x69, 0xaa, 0x27, 0xee, 0xf9, 0xdd, 0xfa, 0x8f,
+	0x5d, 0x7d, 0x9d, 0x6e, 0xb9, 0xc7, 0xf5, 0xea, 0xf7, 0x00, 0xdd, 0xa4, 0xb9, 0x2a, 0x9a, 0x03,
+	0x00, 0x00,
+}
+
+func (m *WebAuthnCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebAuthnCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebAuthnCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintWebauthn(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Attachment) > 0 {
+		i -= len(m.Attachment)
+		copy(dAtA[i:], m.Attachment)
+		i = encodeVarintWebauthn(dAtA, i, uint64(len(m.Attachment)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.CloneWarning {
+		i--
+		if m.CloneWarning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SignCount != 0 {
+		i = encodeVarintWebauthn(dAtA, i, uint64(m.SignCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Aaguid) > 0 {
+		i -= len(m.Aaguid)
+		copy(dAtA[i:], m.Aaguid)
+		i = encodeVarintWebauthn(dAtA, i, uint64(len(m.Aaguid)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BackupState {
+		i--
+		if m.BackupState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BackupEligible {
+		i--
+		if m.BackupEligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UserVerified {
+		i--
+		if m.UserVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UserPresent {
+		i--
+		if m.UserPresent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Transports) > 0 {
+		for iNdEx := len(m.Transports) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transports[iNdEx])
+			copy(dAtA[i:], m.Transports[iNdEx])
+			i = encodeVarintWebauthn(dAtA, i, uint64(len(m.Transports[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AttestationType) > 0 {
+		i -= len(m.AttestationType)
+		copy(dAtA[i:], m.AttestationType)
+		i = encodeVarintWebauthn(dAtA, i, uint64(len(m.AttestationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintWebauthn(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintWebauthn(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WebAuthnCredentialRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebAuthnCredentialRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebAuthnCredentialRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Credential != nil {
+		{
+			size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWebauthn(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintWebauthn(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrustAnchors) > 0 {
+		for iNdEx := len(m.TrustAnchors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TrustAnchors[iNdEx])
+			copy(dAtA[i:], m.TrustAnchors[iNdEx])
+			i = encodeVarintWebauthn(dAtA, i, uint64(len(m.TrustAnchors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Aaguids) > 0 {
+		for iNdEx := len(m.Aaguids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aaguids[iNdEx])
+			copy(dAtA[i:], m.Aaguids[iNdEx])
+			i = encodeVarintWebauthn(dAtA, i, uint64(len(m.Aaguids[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Formats) > 0 {
+		for iNdEx := len(m.Formats) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Formats[iNdEx])
+			copy(dAtA[i:], m.Formats[iNdEx])
+			i = encodeVarintWebauthn(dAtA, i, uint64(len(m.Formats[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WebAuthnChallengeBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebAuthnChallengeBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebAuthnChallengeBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintWebauthn(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SignDocHash) > 0 {
+		i -= len(m.SignDocHash)
+		copy(dAtA[i:], m.SignDocHash)
+		i = encodeVarintWebauthn(dAtA, i, uint64(len(m.SignDocHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWebauthn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWebauthn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WebAuthnCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	l = len(m.AttestationType)
+	if l > 0 {
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	if len(m.Transports) > 0 {
+		for _, s := range m.Transports {
+			l = len(s)
+			n += 1 + l + sovWebauthn(uint64(l))
+		}
+	}
+	if m.UserPresent {
+		n += 2
+	}
+	if m.UserVerified {
+		n += 2
+	}
+	if m.BackupEligible {
+		n += 2
+	}
+	if m.BackupState {
+		n += 2
+	}
+	l = len(m.Aaguid)
+	if l > 0 {
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	if m.SignCount != 0 {
+		n += 1 + sovWebauthn(uint64(m.SignCount))
+	}
+	if m.CloneWarning {
+		n += 2
+	}
+	l = len(m.Attachment)
+	if l > 0 {
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovWebauthn(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *WebAuthnCredentialRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	if m.Credential != nil {
+		l = m.Credential.Size()
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for _, s := range m.Formats {
+			l = len(s)
+			n += 1 + l + sovWebauthn(uint64(l))
+		}
+	}
+	if len(m.Aaguids) > 0 {
+		for _, b := range m.Aaguids {
+			l = len(b)
+			n += 1 + l + sovWebauthn(uint64(l))
+		}
+	}
+	if len(m.TrustAnchors) > 0 {
+		for _, b := range m.TrustAnchors {
+			l = len(b)
+			n += 1 + l + sovWebauthn(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WebAuthnChallengeBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignDocHash)
+	if l > 0 {
+		n += 1 + l + sovWebauthn(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovWebauthn(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovWebauthn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWebauthn(x uint64) (n int) {
+	return sovWebauthn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WebAuthnCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebauthn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebAuthnCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebAuthnCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = append(m.Id[:0], dAtA[iNdEx:postIndex]...)
+			if m.Id == nil {
+				m.Id = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transports", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transports = append(m.Transports, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPresent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserPresent = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserVerified = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupEligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackupEligible = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackupState = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aaguid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aaguid = append(m.Aaguid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Aaguid == nil {
+				m.Aaguid = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignCount", wireType)
+			}
+			m.SignCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloneWarning", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CloneWarning = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attachment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebauthn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebAuthnCredentialRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebauthn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebAuthnCredentialRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebAuthnCredentialRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credential == nil {
+				m.Credential = &WebAuthnCredential{}
+			}
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebauthn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebauthn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formats", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Formats = append(m.Formats, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aaguids", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aaguids = append(m.Aaguids, make([]byte, postIndex-iNdEx))
+			copy(m.Aaguids[len(m.Aaguids)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustAnchors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustAnchors = append(m.TrustAnchors, make([]byte, postIndex-iNdEx))
+			copy(m.TrustAnchors[len(m.TrustAnchors)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebauthn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebAuthnChallengeBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWebauthn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebAuthnChallengeBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebAuthnChallengeBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignDocHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignDocHash = append(m.SignDocHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignDocHash == nil {
+				m.SignDocHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWebauthn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWebauthn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWebauthn(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWebauthn
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWebauthn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWebauthn
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWebauthn
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWebauthn
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWebauthn        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWebauthn          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWebauthn = fmt.Errorf("proto: unexpected end of group")
+)